@@ -0,0 +1,285 @@
+package distance_metrics
+
+import (
+	"math"
+
+	"github.com/rexsimiloluwah/distance_metrics/distribution"
+	"github.com/rexsimiloluwah/distance_metrics/geo"
+	"github.com/rexsimiloluwah/distance_metrics/set"
+	"github.com/rexsimiloluwah/distance_metrics/text"
+	"github.com/rexsimiloluwah/distance_metrics/vector"
+)
+
+// GeoPoint is a point on the Earth given by its longitude and latitude in degrees
+type GeoPoint struct {
+	Lon float64
+	Lat float64
+}
+
+// Registries of the metrics provided by the packages of this module, keyed by name
+// Additional metrics can be registered on them, and metric names can be read from a configuration file:
+//
+//	m, err := distance_metrics.Vectors.Lookup("minkowski", distance_metrics.Params{"p": 3})
+var (
+	Vectors       = NewRegistry[vector.Vector]()
+	SparseVectors = NewRegistry[vector.Sparse]()
+	BinaryVectors = NewRegistry[vector.Bits]()
+	Sets          = NewRegistry[*set.Set]()
+	Strings       = NewRegistry[string]()
+	GeoPoints     = NewRegistry[GeoPoint]()
+)
+
+// Returns the properties of a true metric, which is always a symmetric distance
+func trueMetric(name string, r Range) Info {
+	return Info{Name: name, Kind: Distance, IsMetric: true, Symmetric: true, Range: r}
+}
+
+// Returns the properties of a symmetric distance that violates the triangle inequality
+func distance(name string, r Range) Info {
+	return Info{Name: name, Kind: Distance, Symmetric: true, Range: r}
+}
+
+// Returns the properties of a symmetric similarity
+func similarity(name string, r Range) Info {
+	return Info{Name: name, Kind: Similarity, Symmetric: true, Range: r}
+}
+
+// Reports whether every weight is positive, which weighted distances need to be metrics
+// Missing weights are all 1
+func positive(w vector.Vector) bool {
+	for _, value := range w {
+		if !(value > 0) {
+			return false
+		}
+	}
+	return true
+}
+
+func init() {
+	registerVectors()
+	registerDistributions()
+	registerSparseVectors()
+	registerBinaryVectors()
+	registerSets()
+	registerStrings()
+	registerGeoPoints()
+}
+
+func registerVectors() {
+	r := Vectors
+
+	r.mustRegister("minkowski", func(params Params) (Metric[vector.Vector], error) {
+		p, err := params.Float("p", 2)
+		if err != nil {
+			return nil, err
+		}
+		if !(p > 0) {
+			return nil, ErrInvalidParameter
+		}
+		info := distance("minkowski", NonNegative)
+		info.IsMetric = p >= 1
+		return NewMetric(info, func(a vector.Vector, b vector.Vector) (float64, error) {
+			return vector.TryMinkowskiFloat(a, b, p)
+		}), nil
+	})
+	r.registerFixed(NewMetric(trueMetric("manhattan", NonNegative), func(a vector.Vector, b vector.Vector) (float64, error) {
+		return vector.TryMinkowskiFloat(a, b, 1)
+	}))
+	r.registerFixed(NewMetric(trueMetric("euclidean", NonNegative), func(a vector.Vector, b vector.Vector) (float64, error) {
+		return vector.TryMinkowskiFloat(a, b, 2)
+	}))
+	r.registerFixed(NewMetric(distance("squared_euclidean", NonNegative), func(a vector.Vector, b vector.Vector) (float64, error) {
+		result, err := vector.TryMinkowskiFloat(a, b, 2)
+		return result * result, err
+	}))
+	r.registerFixed(NewMetric(trueMetric("chebyshev", NonNegative), vector.TryChebyshev))
+	r.registerFixed(NewMetric(trueMetric("hamming", NonNegative), func(a vector.Vector, b vector.Vector) (float64, error) {
+		result, err := vector.TryHamming(a, b)
+		return float64(result), err
+	}))
+
+	r.mustRegister("weighted_minkowski", func(params Params) (Metric[vector.Vector], error) {
+		p, err := params.Float("p", 2)
+		if err != nil {
+			return nil, err
+		}
+		w, err := params.Vector("weights", true)
+		if err != nil {
+			return nil, err
+		}
+		if !(p > 0) {
+			return nil, ErrInvalidParameter
+		}
+		info := distance("weighted_minkowski", NonNegative)
+		info.IsMetric = p >= 1 && positive(w)
+		return NewMetric(info, func(a vector.Vector, b vector.Vector) (float64, error) {
+			return vector.TryWeightedMinkowski(a, b, w, p)
+		}), nil
+	})
+	weighted := func(name string, p float64, squared bool) {
+		r.mustRegister(name, func(params Params) (Metric[vector.Vector], error) {
+			w, err := params.Vector("weights", true)
+			if err != nil {
+				return nil, err
+			}
+			// A zero weight ignores a dimension, so distinct vectors can be 0 apart
+			info := trueMetric(name, NonNegative)
+			info.IsMetric = !squared && positive(w)
+			return NewMetric(info, func(a vector.Vector, b vector.Vector) (float64, error) {
+				result, err := vector.TryWeightedMinkowski(a, b, w, p)
+				if squared {
+					result *= result
+				}
+				return result, err
+			}), nil
+		})
+	}
+	weighted("weighted_manhattan", 1, false)
+	weighted("weighted_euclidean", 2, false)
+	weighted("weighted_squared_euclidean", 2, true)
+	weighted("weighted_chebyshev", math.Inf(1), false)
+
+	r.mustRegister("standardized_euclidean", func(params Params) (Metric[vector.Vector], error) {
+		variance, err := params.Vector("variance", false)
+		if err != nil {
+			return nil, err
+		}
+		return NewMetric(trueMetric("standardized_euclidean", NonNegative), func(a vector.Vector, b vector.Vector) (float64, error) {
+			return vector.TryStandardizedEuclidean(a, b, variance)
+		}), nil
+	})
+	r.mustRegister("mahalanobis", func(params Params) (Metric[vector.Vector], error) {
+		invCov, err := params.Matrix("inverse_covariance")
+		if err != nil {
+			return nil, err
+		}
+		return NewMetric(trueMetric("mahalanobis", NonNegative), func(a vector.Vector, b vector.Vector) (float64, error) {
+			return vector.TryMahalanobis(a, b, invCov)
+		}), nil
+	})
+
+	r.registerFixed(NewMetric(trueMetric("canberra", NonNegative), vector.TryCanberra))
+	r.registerFixed(NewMetric(distance("bray_curtis", UnitRange), vector.TryBrayCurtis))
+	r.registerFixed(NewMetric(distance("chi_square", NonNegative), vector.TryChiSquare))
+
+	r.registerFixed(NewMetric(similarity("cosine_similarity", SignedUnit), vector.TryCosineSimilarity))
+	r.registerFixed(NewMetric(distance("cosine_dissimilarity", Range{0, 2}), func(a vector.Vector, b vector.Vector) (float64, error) {
+		result, err := vector.TryCosineSimilarity(a, b)
+		return 1 - result, err
+	}))
+	r.registerFixed(NewMetric(trueMetric("angular", UnitRange), vector.TryAngularDistance))
+
+	r.registerFixed(NewMetric(similarity("covariance", Unbounded), vector.TryCovariance))
+	r.registerFixed(NewMetric(similarity("pearson_correlation", SignedUnit), vector.TryPearsonCorrelation))
+	r.registerFixed(NewMetric(distance("correlation_distance", Range{0, 2}), vector.TryCorrelationDistance))
+	r.registerFixed(NewMetric(similarity("spearman_correlation", SignedUnit), vector.TrySpearmanCorrelation))
+	r.registerFixed(NewMetric(distance("spearman_distance", Range{0, 2}), vector.TrySpearmanDistance))
+	r.registerFixed(NewMetric(similarity("kendall_tau", SignedUnit), vector.TryKendallTau))
+	r.registerFixed(NewMetric(distance("kendall_distance", UnitRange), vector.TryKendallDistance))
+}
+
+// Registers the divergences between probability distributions, which accept the
+// "normalize" (boolean), "epsilon" and "tolerance" (number) parameters of distribution.Options
+func registerDistributions() {
+	divergence := func(info Info, f func(o distribution.Options, p vector.Vector, q vector.Vector) (float64, error)) {
+		Vectors.mustRegister(info.Name, func(params Params) (Metric[vector.Vector], error) {
+			normalize, err := params.Bool("normalize", false)
+			if err != nil {
+				return nil, err
+			}
+			epsilon, err := params.Float("epsilon", 0)
+			if err != nil {
+				return nil, err
+			}
+			tolerance, err := params.Float("tolerance", 0)
+			if err != nil {
+				return nil, err
+			}
+			if epsilon < 0 || math.IsNaN(epsilon) || tolerance < 0 || math.IsNaN(tolerance) {
+				return nil, ErrInvalidParameter
+			}
+			o := distribution.Options{Normalize: normalize, Epsilon: epsilon, Tolerance: tolerance}
+			return NewMetric(info, func(p vector.Vector, q vector.Vector) (float64, error) {
+				return f(o, p, q)
+			}), nil
+		})
+	}
+
+	divergence(Info{Name: "kullback_leibler", Kind: Distance, Range: NonNegative}, distribution.Options.KullbackLeibler)
+	divergence(distance("symmetric_kullback_leibler", NonNegative), distribution.Options.SymmetricKullbackLeibler)
+	divergence(distance("jensen_shannon_divergence", Range{0, math.Ln2}), distribution.Options.JensenShannonDivergence)
+	divergence(trueMetric("jensen_shannon_distance", Range{0, math.Sqrt(math.Ln2)}), distribution.Options.JensenShannonDistance)
+	divergence(trueMetric("hellinger", UnitRange), distribution.Options.Hellinger)
+	divergence(similarity("bhattacharyya_coefficient", UnitRange), distribution.Options.BhattacharyyaCoefficient)
+	divergence(distance("bhattacharyya_distance", NonNegative), distribution.Options.BhattacharyyaDistance)
+	divergence(trueMetric("total_variation", UnitRange), distribution.Options.TotalVariation)
+}
+
+func registerSparseVectors() {
+	r := SparseVectors
+	r.registerFixed(NewMetric(similarity("cosine_similarity", SignedUnit), vector.TrySparseCosineSimilarity))
+	r.registerFixed(NewMetric(trueMetric("euclidean", NonNegative), vector.TrySparseEuclidean))
+	r.registerFixed(NewMetric(trueMetric("manhattan", NonNegative), vector.TrySparseManhattan))
+	r.registerFixed(NewMetric(trueMetric("chebyshev", NonNegative), vector.TrySparseChebyshev))
+	r.registerFixed(NewMetric(similarity("jaccard", UnitRange), vector.TrySparseJaccard))
+}
+
+func registerBinaryVectors() {
+	r := BinaryVectors
+	r.registerFixed(NewMetric(trueMetric("hamming", NonNegative), func(x vector.Bits, y vector.Bits) (float64, error) {
+		result, err := vector.TryBinaryHamming(x, y)
+		return float64(result), err
+	}))
+	r.registerFixed(NewMetric(similarity("jaccard", UnitRange), vector.TryBinaryJaccard))
+	r.registerFixed(NewMetric(similarity("dice", UnitRange), vector.TryBinaryDice))
+	r.registerFixed(NewMetric(similarity("russell_rao", UnitRange), vector.TryRussellRao))
+	r.registerFixed(NewMetric(similarity("sokal_michener", UnitRange), vector.TrySokalMichener))
+	r.registerFixed(NewMetric(similarity("rogers_tanimoto", UnitRange), vector.TryRogersTanimoto))
+	r.registerFixed(NewMetric(similarity("yule", SignedUnit), vector.TryYule))
+	r.registerFixed(NewMetric(similarity("kulczynski", UnitRange), vector.TryKulczynski))
+}
+
+func registerSets() {
+	r := Sets
+	r.registerFixed(NewInfallibleMetric(similarity("jaccard", UnitRange), set.Jaccard))
+	r.registerFixed(NewInfallibleMetric(trueMetric("jaccard_distance", UnitRange), set.JaccardDistance))
+	r.registerFixed(NewInfallibleMetric(similarity("sorensen", UnitRange), set.Sorensen))
+	r.mustRegister("tversky", func(params Params) (Metric[*set.Set], error) {
+		alpha, err := params.Float("alpha", 1)
+		if err != nil {
+			return nil, err
+		}
+		beta, err := params.Float("beta", 1)
+		if err != nil {
+			return nil, err
+		}
+		if alpha < 0 || beta < 0 || alpha+beta == 0 {
+			return nil, ErrInvalidParameter
+		}
+		info := similarity("tversky", UnitRange)
+		info.Symmetric = alpha == beta
+		return NewInfallibleMetric(info, func(a *set.Set, b *set.Set) float64 {
+			return set.Tversky(a, b, alpha, beta)
+		}), nil
+	})
+}
+
+func registerStrings() {
+	Strings.registerFixed(NewInfallibleMetric(trueMetric("levenshtein", NonNegative), func(a string, b string) float64 {
+		return float64(text.Levensthein(a, b))
+	}))
+}
+
+func registerGeoPoints() {
+	// The range depends on geo.EARTH_RADIUS, so it is read when the metric is looked up
+	greatCircle := func(name string, f func(lon1 float64, lat1 float64, lon2 float64, lat2 float64) float64) {
+		GeoPoints.mustRegister(name, func(params Params) (Metric[GeoPoint], error) {
+			info := trueMetric(name, Range{0, math.Pi * geo.EARTH_RADIUS})
+			return NewInfallibleMetric(info, func(a GeoPoint, b GeoPoint) float64 {
+				return f(a.Lon, a.Lat, b.Lon, b.Lat)
+			}), nil
+		})
+	}
+	greatCircle("great_circle", geo.GreatCircle)
+	greatCircle("haversine", geo.Haversine)
+}
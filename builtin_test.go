@@ -0,0 +1,131 @@
+package distance_metrics
+
+import (
+	"math"
+	"testing"
+
+	"github.com/rexsimiloluwah/distance_metrics/distribution"
+	"github.com/rexsimiloluwah/distance_metrics/geo"
+	"github.com/rexsimiloluwah/distance_metrics/set"
+	"github.com/rexsimiloluwah/distance_metrics/text"
+	"github.com/rexsimiloluwah/distance_metrics/vector"
+)
+
+// Parameters needed by the metrics that have no defaults
+var requiredParams = map[string]Params{
+	"standardized_euclidean": {"variance": []float64{1, 2, 3, 4}},
+	"mahalanobis":            {"inverse_covariance": vector.Identity(4)},
+}
+
+// Checks that every metric of a registry agrees with its Info on a pair of sample inputs
+func checkRegistry[T any](t *testing.T, r *Registry[T], a T, b T) map[string]float64 {
+	values := make(map[string]float64)
+	for _, name := range r.Names() {
+		m, err := r.Lookup(name, requiredParams[name])
+		if err != nil {
+			t.Error("Test Failed,", name, err)
+			continue
+		}
+		info := m.Info()
+		if info.Name != name {
+			t.Error("Test Failed,", name, " expected,", info.Name, " received.")
+		}
+
+		ab, err := m.Compute(a, b)
+		if err != nil {
+			t.Error("Test Failed,", name, err)
+			continue
+		}
+		if !info.Range.Contains(ab) {
+			t.Error("Test Failed,", name, "value within", info.Range, " expected,", ab, " received.")
+		}
+		if ba, _ := m.Compute(b, a); info.Symmetric && math.Abs(ab-ba) > floatDifferenceThresh {
+			t.Error("Test Failed,", name, "symmetric value", ab, " expected,", ba, " received.")
+		}
+		if aa, _ := m.Compute(a, a); info.IsMetric && math.Abs(aa) > floatDifferenceThresh {
+			t.Error("Test Failed,", name, "zero self-distance expected,", aa, " received.")
+		}
+		values[name] = ab
+	}
+	return values
+}
+
+func TestVectorMetrics(t *testing.T) {
+	a, b := vector.Vector{0.1, 0.2, 0.3, 0.4}, vector.Vector{0.4, 0.1, 0.3, 0.2}
+	values := checkRegistry(t, Vectors, a, b)
+
+	var tests = []struct {
+		name     string
+		expected float64
+	}{
+		{"euclidean", vector.Euclidean(a, b)},
+		{"minkowski", vector.Minkowski(a, b, 2)},
+		{"weighted_chebyshev", vector.Chebyshev(a, b)},
+		{"squared_euclidean", vector.SquaredEuclidean(a, b)},
+		{"standardized_euclidean", vector.StandardizedEuclidean(a, b, vector.Vector{1, 2, 3, 4})},
+		{"mahalanobis", vector.Euclidean(a, b)},
+		{"cosine_dissimilarity", vector.CosineDissimilarity(a, b)},
+		{"spearman_distance", vector.SpearmanDistance(a, b)},
+		{"kendall_distance", vector.KendallDistance(a, b)},
+		{"hamming", float64(vector.Hamming(a, b))},
+		{"kullback_leibler", distribution.KullbackLeibler(a, b)},
+		{"jensen_shannon_distance", distribution.JensenShannonDistance(a, b)},
+	}
+	for _, test := range tests {
+		if output := values[test.name]; math.Abs(output-test.expected) > floatDifferenceThresh {
+			t.Error("Test Failed,", test.name, test.expected, " expected,", output, " received.")
+		}
+	}
+
+	m, _ := Vectors.Lookup("minkowski", Params{"p": 0.5})
+	if m.Info().IsMetric {
+		t.Error("Test Failed, Minkowski with p < 1 is not a metric")
+	}
+	for _, name := range []string{"weighted_manhattan", "weighted_euclidean", "weighted_chebyshev"} {
+		m, _ = Vectors.Lookup(name, Params{"weights": []interface{}{1, 2, 0.5}})
+		if !m.Info().IsMetric {
+			t.Error("Test Failed,", name, "with positive weights is a metric")
+		}
+		m, _ = Vectors.Lookup(name, Params{"weights": []interface{}{1, 0, 0.5}})
+		if m.Info().IsMetric {
+			t.Error("Test Failed,", name, "with a zero weight is not a metric")
+		}
+	}
+	m, _ = Vectors.Lookup("minkowski", Params{"p": 1})
+	if output, _ := m.Compute(a, b); math.Abs(output-vector.Manhattan(a, b)) > floatDifferenceThresh {
+		t.Error("Test Failed,", vector.Manhattan(a, b), " expected,", output, " received.")
+	}
+}
+
+func TestOtherMetrics(t *testing.T) {
+	sparse := checkRegistry(t, SparseVectors, vector.ToSparse(vector.Vector{1, 0, 2, 0}), vector.ToSparse(vector.Vector{0, 0, 2, 3}))
+	if output, expected := sparse["jaccard"], 1.0/3; math.Abs(output-expected) > floatDifferenceThresh {
+		t.Error("Test Failed,", expected, " expected,", output, " received.")
+	}
+
+	binary := checkRegistry(t, BinaryVectors, vector.BitsFromBools([]bool{true, true, false, false}), vector.BitsFromBools([]bool{true, false, true, false}))
+	if output := binary["hamming"]; output != 2 {
+		t.Error("Test Failed,", 2, " expected,", output, " received.")
+	}
+
+	s1, s2 := set.NewSet([]float64{1, 2, 3}), set.NewSet([]float64{2, 3, 4, 5})
+	sets := checkRegistry(t, Sets, s1, s2)
+	if output, expected := sets["tversky"], set.Jaccard(s1, s2); math.Abs(output-expected) > floatDifferenceThresh {
+		t.Error("Test Failed,", expected, " expected,", output, " received.")
+	}
+	m, _ := Sets.Lookup("tversky", Params{"alpha": 1, "beta": 0.5})
+	if m.Info().Symmetric {
+		t.Error("Test Failed, Tversky with alpha != beta is not symmetric")
+	}
+
+	strings := checkRegistry(t, Strings, "kitten", "sitting")
+	if output, expected := strings["levenshtein"], float64(text.Levensthein("kitten", "sitting")); output != expected {
+		t.Error("Test Failed,", expected, " expected,", output, " received.")
+	}
+
+	lagos, london := GeoPoint{Lon: 3.3792, Lat: 6.5244}, GeoPoint{Lon: -0.1278, Lat: 51.5074}
+	points := checkRegistry(t, GeoPoints, lagos, london)
+	if output, expected := points["haversine"], geo.Haversine(lagos.Lon, lagos.Lat, london.Lon, london.Lat); output != expected {
+		t.Error("Test Failed,", expected, " expected,", output, " received.")
+	}
+}
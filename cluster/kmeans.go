@@ -0,0 +1,417 @@
+package cluster
+
+import (
+	"math"
+	"math/rand"
+	"runtime"
+	"sync"
+
+	"github.com/rexsimiloluwah/distance_metrics/vector"
+)
+
+// KMeansConfig holds the parameters of the k-means algorithms
+// Zero values select the defaults documented on each field.
+type KMeansConfig struct {
+	// K is the number of clusters
+	K int
+	// MaxIterations bounds the number of assignment and update steps (default 300)
+	MaxIterations int
+	// Tolerance stops the iterations once the total squared movement of the centroids falls below
+	// Tolerance times the mean per-dimension variance of the data (default 1e-4)
+	Tolerance float64
+	// Seed makes the k-means++ seeding and the mini-batch sampling reproducible
+	Seed int64
+	// Workers is the number of goroutines of the assignment step (runtime.GOMAXPROCS(0) if <= 0)
+	Workers int
+	// BatchSize is the number of vectors sampled per iteration by MiniBatchKMeans (default 1024)
+	BatchSize int
+	// Distance assigns vectors to centroids (vector.Euclidean if nil)
+	// The update step always moves a centroid to the mean of its cluster, which minimises squared
+	// Euclidean distances, so convergence is only guaranteed for the Euclidean distance; use KMedoids for other metrics.
+	Distance vector.DistanceFunc
+}
+
+// Returns the configuration with the defaults filled in
+func (c KMeansConfig) withDefaults() KMeansConfig {
+	if c.MaxIterations <= 0 {
+		c.MaxIterations = 300
+	}
+	if c.Tolerance <= 0 {
+		c.Tolerance = 1e-4
+	}
+	if c.Workers <= 0 {
+		c.Workers = runtime.GOMAXPROCS(0)
+	}
+	if c.BatchSize <= 0 {
+		c.BatchSize = 1024
+	}
+	return c
+}
+
+// KMeansResult is the outcome of a k-means run
+type KMeansResult struct {
+	// Centroids holds the K cluster centres
+	Centroids []vector.Vector
+	// Labels[i] is the index of the centroid data[i] is assigned to
+	Labels []int
+	// Inertia is the sum of the costs of every vector to its centroid: the squared distance,
+	// or 1 - CosineSimilarity for SphericalKMeans
+	Inertia float64
+	// Iterations is the number of update steps performed
+	Iterations int
+	// Converged reports whether the tolerance was reached before MaxIterations
+	Converged bool
+
+	cost func(v vector.Vector, c vector.Vector) float64
+}
+
+// Returns the index of the centroid closest to a vector
+func (r *KMeansResult) Predict(v vector.Vector) int {
+	label, _ := nearest(v, r.Centroids, r.cost)
+	return label
+}
+
+// space describes how vectors are compared with centroids and how centroids are updated
+type space struct {
+	// cost is the quantity minimised by the clustering, also used to weigh k-means++ seeding
+	cost func(v vector.Vector, c vector.Vector) float64
+	// spherical centroids are rescaled to unit length after every update
+	spherical bool
+}
+
+func euclideanSpace(distance vector.DistanceFunc) space {
+	if distance == nil {
+		return space{cost: squaredEuclidean}
+	}
+	return space{cost: func(v vector.Vector, c vector.Vector) float64 {
+		d := distance(v, c)
+		return d * d
+	}}
+}
+
+var sphericalSpace = space{
+	cost:      func(v vector.Vector, c vector.Vector) float64 { return 1 - v.Dot(c) },
+	spherical: true,
+}
+
+func squaredEuclidean(a vector.Vector, b vector.Vector) float64 {
+	var result float64 = 0
+	for i := range a {
+		d := a[i] - b[i]
+		result += d * d
+	}
+	return result
+}
+
+// Returns the index of the centroid with the lowest cost to v, and that cost
+func nearest(v vector.Vector, centroids []vector.Vector, cost func(v vector.Vector, c vector.Vector) float64) (int, float64) {
+	best, bestCost := 0, math.Inf(1)
+	for i, c := range centroids {
+		if d := cost(v, c); d < bestCost {
+			best, bestCost = i, d
+		}
+	}
+	return best, bestCost
+}
+
+// Validates the data and the number of clusters
+// Infinite coordinates are rejected with ErrInvalidParameter as they make every cost infinite or NaN
+func validateData(data []vector.Vector, k int) error {
+	if len(data) == 0 || data[0].Length() == 0 {
+		return vector.ErrEmptyVector
+	}
+	dim := data[0].Length()
+	for _, v := range data {
+		if v.Length() != dim {
+			return vector.ErrDimensionMismatch
+		}
+		for _, value := range v {
+			if math.IsNaN(value) {
+				return vector.ErrNaN
+			}
+			if math.IsInf(value, 0) {
+				return vector.ErrInvalidParameter
+			}
+		}
+	}
+	if k < 1 || k > len(data) {
+		return vector.ErrInvalidParameter
+	}
+	return nil
+}
+
+// Runs f over [0, n) split into contiguous chunks across a number of goroutines
+func parallel(n int, workers int, f func(lo int, hi int)) {
+	if workers > n {
+		workers = n
+	}
+	if workers <= 1 {
+		f(0, n)
+		return
+	}
+
+	var wg sync.WaitGroup
+	chunk := (n + workers - 1) / workers
+	for lo := 0; lo < n; lo += chunk {
+		hi := lo + chunk
+		if hi > n {
+			hi = n
+		}
+		wg.Add(1)
+		go func(lo int, hi int) {
+			defer wg.Done()
+			f(lo, hi)
+		}(lo, hi)
+	}
+	wg.Wait()
+}
+
+// Chooses k initial centroids with greedy k-means++
+// Each new centroid is sampled with probability proportional to its cost to the closest centroid chosen
+// so far; 2 + ln(k) candidates are sampled per step and the one lowering the total cost most is kept,
+// which makes a poor seeding (two seeds in one cluster) much less likely.
+// Reference: Arthur and Vassilvitskii, "k-means++: The Advantages of Careful Seeding", 2007
+func seed(data []vector.Vector, k int, s space, rng *rand.Rand) []vector.Vector {
+	first := data[rng.Intn(len(data))]
+	centroids := []vector.Vector{clone(first)}
+	costs := make([]float64, len(data))
+	var total float64 = 0
+	for i, v := range data {
+		costs[i] = math.Max(0, s.cost(v, first))
+		total += costs[i]
+	}
+
+	trials := 2 + int(math.Log(float64(k)))
+	candidate := make([]float64, len(data))
+	for len(centroids) < k {
+		best, bestCosts, bestTotal := -1, []float64(nil), math.Inf(1)
+		for trial := 0; trial < trials; trial++ {
+			next := sample(costs, total, rng)
+			var sum float64 = 0
+			for i, v := range data {
+				candidate[i] = math.Min(costs[i], math.Max(0, s.cost(v, data[next])))
+				sum += candidate[i]
+			}
+			// The first trial is always kept, so that a centroid is chosen even if the costs overflow to +Inf
+			if best < 0 || sum < bestTotal {
+				best, bestTotal = next, sum
+				bestCosts = append(bestCosts[:0], candidate...)
+			}
+		}
+		centroids = append(centroids, clone(data[best]))
+		copy(costs, bestCosts)
+		total = bestTotal
+	}
+	return centroids
+}
+
+// Samples an index with probability proportional to its cost, or uniformly if every cost is 0
+func sample(costs []float64, total float64, rng *rand.Rand) int {
+	if total > 0 {
+		r := rng.Float64() * total
+		for i, c := range costs {
+			if r -= c; r <= 0 && c > 0 {
+				return i
+			}
+		}
+	}
+	return rng.Intn(len(costs))
+}
+
+func clone(v vector.Vector) vector.Vector {
+	return append(vector.Vector(nil), v...)
+}
+
+// Returns the mean per-dimension variance of the data, which scales the tolerance
+func meanVariance(data []vector.Vector) float64 {
+	var result float64 = 0
+	for j := 0; j < data[0].Length(); j++ {
+		var s vector.Stats
+		for _, v := range data {
+			s.Push(v[j])
+		}
+		result += s.PopulationVariance()
+	}
+	return result / float64(data[0].Length())
+}
+
+// Rescales a vector to unit length in place, leaving the zero vector unchanged
+func normalize(v vector.Vector) {
+	m := v.Magnitude()
+	if m == 0 {
+		return
+	}
+	for i := range v {
+		v[i] /= m
+	}
+}
+
+// Clusters the data with Lloyd's algorithm from k-means++ seeds
+// Every iteration assigns each vector to its nearest centroid in parallel, then moves each centroid
+// to the mean of its cluster; a cluster left empty is re-seeded with the vector farthest from its centroid.
+// Reference: https://en.wikipedia.org/wiki/K-means_clustering
+func KMeans(data []vector.Vector, config KMeansConfig) (*KMeansResult, error) {
+	if err := validateData(data, config.K); err != nil {
+		return nil, err
+	}
+	return lloyd(data, config.withDefaults(), euclideanSpace(config.Distance)), nil
+}
+
+// Clusters unit-normalised data by cosine similarity with spherical k-means
+// Vectors are assigned to the centroid of highest vector.CosineSimilarity, and centroids are the
+// normalised means of their clusters. The returned centroids have unit length.
+// ErrZeroMagnitude is returned if any vector is the zero vector.
+// Reference: Dhillon and Modha, "Concept Decompositions for Large Sparse Text Data using Clustering", 2001
+func SphericalKMeans(data []vector.Vector, config KMeansConfig) (*KMeansResult, error) {
+	if err := validateData(data, config.K); err != nil {
+		return nil, err
+	}
+
+	normalized := make([]vector.Vector, len(data))
+	for i, v := range data {
+		if v.Magnitude() == 0 {
+			return nil, vector.ErrZeroMagnitude
+		}
+		normalized[i] = clone(v)
+		normalize(normalized[i])
+	}
+	return lloyd(normalized, config.withDefaults(), sphericalSpace), nil
+}
+
+func lloyd(data []vector.Vector, config KMeansConfig, s space) *KMeansResult {
+	rng := rand.New(rand.NewSource(config.Seed))
+	k, dim := config.K, data[0].Length()
+	tol := config.Tolerance * meanVariance(data)
+	if s.spherical {
+		tol = config.Tolerance
+	}
+
+	result := &KMeansResult{Centroids: seed(data, k, s, rng), Labels: make([]int, len(data)), cost: s.cost}
+	costs := make([]float64, len(data))
+	assign := func() {
+		parallel(len(data), config.Workers, func(lo int, hi int) {
+			for i := lo; i < hi; i++ {
+				result.Labels[i], costs[i] = nearest(data[i], result.Centroids, s.cost)
+			}
+		})
+	}
+
+	assign()
+	for result.Iterations < config.MaxIterations {
+		sums := make([]vector.Vector, k)
+		counts := make([]int, k)
+		for c := range sums {
+			sums[c] = make(vector.Vector, dim)
+		}
+		for i, v := range data {
+			counts[result.Labels[i]]++
+			for j, value := range v {
+				sums[result.Labels[i]][j] += value
+			}
+		}
+
+		var shift float64 = 0
+		for c := range sums {
+			if counts[c] == 0 {
+				// Re-seed the empty cluster with the worst-served vector
+				far := 0
+				for i := range costs {
+					if costs[i] > costs[far] {
+						far = i
+					}
+				}
+				copy(sums[c], data[far])
+				costs[far] = 0
+			} else {
+				for j := range sums[c] {
+					sums[c][j] /= float64(counts[c])
+				}
+			}
+			if s.spherical {
+				normalize(sums[c])
+			}
+			shift += squaredEuclidean(sums[c], result.Centroids[c])
+			result.Centroids[c] = sums[c]
+		}
+		result.Iterations++
+
+		assign()
+		if shift <= tol {
+			result.Converged = true
+			break
+		}
+	}
+
+	for _, c := range costs {
+		result.Inertia += c
+	}
+	return result
+}
+
+// Clusters the data with mini-batch k-means from k-means++ seeds
+// Every iteration samples BatchSize vectors, assigns them to their nearest centroid and moves each
+// centroid towards its assigned samples with a per-centroid learning rate of 1/count, so that the
+// cost of an iteration does not depend on the size of the data. Labels and inertia are computed on all the data.
+// Reference: Sculley, "Web-Scale K-Means Clustering", 2010
+func MiniBatchKMeans(data []vector.Vector, config KMeansConfig) (*KMeansResult, error) {
+	if err := validateData(data, config.K); err != nil {
+		return nil, err
+	}
+
+	config = config.withDefaults()
+	s := euclideanSpace(config.Distance)
+	rng := rand.New(rand.NewSource(config.Seed))
+	tol := config.Tolerance * meanVariance(data)
+	batch := config.BatchSize
+	if batch > len(data) {
+		batch = len(data)
+	}
+
+	result := &KMeansResult{Centroids: seed(data, config.K, s, rng), Labels: make([]int, len(data)), cost: s.cost}
+	counts := make([]int, config.K)
+	samples, labels := make([]int, batch), make([]int, batch)
+	for result.Iterations < config.MaxIterations {
+		for b := range samples {
+			samples[b] = rng.Intn(len(data))
+		}
+		parallel(batch, config.Workers, func(lo int, hi int) {
+			for b := lo; b < hi; b++ {
+				labels[b], _ = nearest(data[samples[b]], result.Centroids, s.cost)
+			}
+		})
+
+		previous := make([]vector.Vector, config.K)
+		for c, centroid := range result.Centroids {
+			previous[c] = clone(centroid)
+		}
+		for b, i := range samples {
+			c := labels[b]
+			counts[c]++
+			rate := 1 / float64(counts[c])
+			for j, value := range data[i] {
+				result.Centroids[c][j] += rate * (value - result.Centroids[c][j])
+			}
+		}
+		result.Iterations++
+
+		var shift float64 = 0
+		for c := range previous {
+			shift += squaredEuclidean(previous[c], result.Centroids[c])
+		}
+		if shift <= tol {
+			result.Converged = true
+			break
+		}
+	}
+
+	costs := make([]float64, len(data))
+	parallel(len(data), config.Workers, func(lo int, hi int) {
+		for i := lo; i < hi; i++ {
+			result.Labels[i], costs[i] = nearest(data[i], result.Centroids, s.cost)
+		}
+	})
+	for _, c := range costs {
+		result.Inertia += c
+	}
+	return result, nil
+}
@@ -0,0 +1,190 @@
+package cluster
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/rexsimiloluwah/distance_metrics/vector"
+)
+
+const floatDifferenceThresh = 1e-4
+
+// Returns n points around each of the given centres, with labels giving the centre of every point
+func blobs(rng *rand.Rand, centres []vector.Vector, n int, spread float64) ([]vector.Vector, []int) {
+	var data []vector.Vector
+	var labels []int
+	for c, centre := range centres {
+		for i := 0; i < n; i++ {
+			v := make(vector.Vector, centre.Length())
+			for j := range v {
+				v[j] = centre[j] + spread*rng.NormFloat64()
+			}
+			data = append(data, v)
+			labels = append(labels, c)
+		}
+	}
+	return data, labels
+}
+
+// Reports whether two labellings define the same partition, up to renaming of the clusters
+func samePartition(a []int, b []int) bool {
+	forward, backward := map[int]int{}, map[int]int{}
+	for i := range a {
+		if x, ok := forward[a[i]]; ok && x != b[i] {
+			return false
+		}
+		if y, ok := backward[b[i]]; ok && y != a[i] {
+			return false
+		}
+		forward[a[i]], backward[b[i]] = b[i], a[i]
+	}
+	return true
+}
+
+var centres = []vector.Vector{{0, 0}, {10, 0}, {0, 10}, {10, 10}}
+
+func TestKMeans(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	data, expected := blobs(rng, centres, 50, 1)
+
+	result, err := KMeans(data, KMeansConfig{K: 4, Seed: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !samePartition(result.Labels, expected) {
+		t.Error("Test Failed, the planted clusters expected,", result.Labels, " received.")
+	}
+	if !result.Converged || result.Iterations == 0 {
+		t.Error("Test Failed, convergence expected,", result.Iterations, " iterations received.")
+	}
+
+	// Inertia is the sum of squared distances to the assigned centroids
+	var inertia float64 = 0
+	for i, v := range data {
+		d := vector.Euclidean(v, result.Centroids[result.Labels[i]])
+		inertia += d * d
+		if result.Predict(v) != result.Labels[i] {
+			t.Error("Test Failed,", result.Labels[i], " expected,", result.Predict(v), " received.")
+		}
+	}
+	if math.Abs(inertia-result.Inertia) > floatDifferenceThresh {
+		t.Error("Test Failed,", inertia, " expected,", result.Inertia, " received.")
+	}
+
+	// The same seed gives the same result, whatever the number of workers
+	again, _ := KMeans(data, KMeansConfig{K: 4, Seed: 1, Workers: 1})
+	if again.Inertia != result.Inertia || again.Iterations != result.Iterations {
+		t.Error("Test Failed,", result.Inertia, " expected,", again.Inertia, " received.")
+	}
+
+	// A custom distance is used for the assignments
+	manhattan, _ := KMeans(data, KMeansConfig{K: 4, Seed: 1, Distance: vector.Manhattan})
+	if !samePartition(manhattan.Labels, expected) {
+		t.Error("Test Failed, the planted clusters expected,", manhattan.Labels, " received.")
+	}
+}
+
+func TestKMeansMaxIterations(t *testing.T) {
+	rng := rand.New(rand.NewSource(2))
+	data, _ := blobs(rng, centres, 50, 4)
+	result, err := KMeans(data, KMeansConfig{K: 4, Seed: 3, MaxIterations: 1, Tolerance: 1e-12})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Iterations != 1 || result.Converged {
+		t.Error("Test Failed,", 1, " iteration expected,", result.Iterations, result.Converged, " received.")
+	}
+}
+
+func TestMiniBatchKMeans(t *testing.T) {
+	rng := rand.New(rand.NewSource(4))
+	data, expected := blobs(rng, centres, 500, 1)
+
+	result, err := MiniBatchKMeans(data, KMeansConfig{K: 4, Seed: 1, BatchSize: 64, MaxIterations: 200})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !samePartition(result.Labels, expected) {
+		t.Error("Test Failed, the planted clusters expected")
+	}
+	full, _ := KMeans(data, KMeansConfig{K: 4, Seed: 1})
+	if result.Inertia > 1.05*full.Inertia {
+		t.Error("Test Failed, inertia close to", full.Inertia, " expected,", result.Inertia, " received.")
+	}
+}
+
+func TestSphericalKMeans(t *testing.T) {
+	// Directions matter, not magnitudes
+	rng := rand.New(rand.NewSource(5))
+	directions := []vector.Vector{{1, 0, 0}, {0, 1, 0}, {0, 0, 1}}
+	var data []vector.Vector
+	var expected []int
+	for c, d := range directions {
+		for i := 0; i < 40; i++ {
+			scale := 1 + 100*rng.Float64()
+			v := make(vector.Vector, 3)
+			for j := range v {
+				v[j] = scale * (d[j] + 0.1*rng.NormFloat64())
+			}
+			data = append(data, v)
+			expected = append(expected, c)
+		}
+	}
+
+	result, err := SphericalKMeans(data, KMeansConfig{K: 3, Seed: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !samePartition(result.Labels, expected) {
+		t.Error("Test Failed, the planted clusters expected,", result.Labels, " received.")
+	}
+	var inertia float64 = 0
+	for i, v := range data {
+		if m := result.Centroids[result.Labels[i]].Magnitude(); math.Abs(m-1) > floatDifferenceThresh {
+			t.Error("Test Failed, unit centroids expected,", m, " received.")
+		}
+		inertia += 1 - vector.CosineSimilarity(v, result.Centroids[result.Labels[i]])
+	}
+	if math.Abs(inertia-result.Inertia) > floatDifferenceThresh {
+		t.Error("Test Failed,", inertia, " expected,", result.Inertia, " received.")
+	}
+
+	if _, err := SphericalKMeans([]vector.Vector{{1, 0}, {0, 0}}, KMeansConfig{K: 1}); err != vector.ErrZeroMagnitude {
+		t.Error("Test Failed,", vector.ErrZeroMagnitude, " expected,", err, " received.")
+	}
+}
+
+func TestKMeansOverflowingCosts(t *testing.T) {
+	// Squared distances between these points overflow to +Inf, so no k-means++ candidate lowers the total cost
+	data := []vector.Vector{{-1e200}, {0}, {1e200}}
+	result, err := KMeans(data, KMeansConfig{K: 3, Seed: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(result.Centroids) != 3 {
+		t.Error("Test Failed,", 3, " expected,", len(result.Centroids), " received.")
+	}
+}
+
+func TestKMeansErrors(t *testing.T) {
+	var tests = []struct {
+		data     []vector.Vector
+		k        int
+		expected error
+	}{
+		{nil, 1, vector.ErrEmptyVector},
+		{[]vector.Vector{{1, 2}, {3}}, 1, vector.ErrDimensionMismatch},
+		{[]vector.Vector{{1, math.NaN()}}, 1, vector.ErrNaN},
+		{[]vector.Vector{{1, math.Inf(1)}, {0, 0}}, 1, vector.ErrInvalidParameter},
+		{[]vector.Vector{{1, 2}, {0, math.Inf(-1)}}, 2, vector.ErrInvalidParameter},
+		{[]vector.Vector{{1, 2}}, 2, vector.ErrInvalidParameter},
+		{[]vector.Vector{{1, 2}}, 0, vector.ErrInvalidParameter},
+	}
+
+	for _, test := range tests {
+		if _, err := KMeans(test.data, KMeansConfig{K: test.k}); err != test.expected {
+			t.Error("Test Failed,", test.expected, " expected,", err, " received.")
+		}
+	}
+}
@@ -0,0 +1,372 @@
+package cluster
+
+import (
+	"math"
+	"math/rand"
+	"sort"
+
+	"github.com/rexsimiloluwah/distance_metrics/vector"
+)
+
+// Method selects the swap phase of k-medoids
+type Method int
+
+const (
+	// FastPAM evaluates the swap of every medoid with a candidate at once, in O(n^2) per iteration
+	// It finds the same swaps as PAM, k times faster.
+	FastPAM Method = iota
+	// PAM evaluates every medoid and candidate pair separately, in O(k*n^2) per iteration
+	PAM
+)
+
+// KMedoidsConfig holds the parameters of the k-medoids algorithms
+// Zero values select the defaults documented on each field.
+type KMedoidsConfig struct {
+	// K is the number of clusters
+	K int
+	// Method selects the swap phase (FastPAM by default)
+	Method Method
+	// MaxIterations bounds the number of swaps (default 100)
+	MaxIterations int
+	// Samples is the number of subsets CLARA clusters (default 5)
+	Samples int
+	// SampleSize is the number of items in each CLARA subset (default 40 + 2K)
+	SampleSize int
+	// Seed makes the CLARA sampling reproducible
+	Seed int64
+}
+
+// Returns the configuration with the defaults filled in
+func (c KMedoidsConfig) withDefaults() KMedoidsConfig {
+	if c.MaxIterations <= 0 {
+		c.MaxIterations = 100
+	}
+	if c.Samples <= 0 {
+		c.Samples = 5
+	}
+	if c.SampleSize <= 0 {
+		c.SampleSize = 40 + 2*c.K
+	}
+	return c
+}
+
+// KMedoidsResult is the outcome of a k-medoids run
+type KMedoidsResult struct {
+	// Medoids holds the indices of the K items chosen as cluster centres, in increasing order
+	Medoids []int
+	// Labels[i] is the position in Medoids of the medoid item i is assigned to
+	Labels []int
+	// Cost is the sum of the distances of every item to its medoid
+	Cost float64
+	// Iterations is the number of swaps performed, summed over every sample for CLARA
+	Iterations int
+}
+
+// Clusters items under an arbitrary distance with k-medoids
+// Unlike k-means, the cluster centres are items themselves, so any distance can be used, e.g. text.Levensthein
+// on strings or set.JaccardDistance on sets. The distance is assumed to be symmetric; all n*(n-1)/2 pairwise
+// distances are computed and kept in memory, so use CLARA for large inputs.
+// ErrInvalidParameter is returned if the distance function returns a negative or infinite value.
+// Reference: Schubert and Rousseeuw, "Faster k-Medoids Clustering: Improving the PAM, CLARA, and CLARANS Algorithms", 2019
+func KMedoids[T any](items []T, distance func(a T, b T) float64, config KMedoidsConfig) (*KMedoidsResult, error) {
+	if len(items) == 0 {
+		return nil, vector.ErrEmptyVector
+	}
+	if config.K < 1 || config.K > len(items) {
+		return nil, vector.ErrInvalidParameter
+	}
+
+	d := distanceMatrix(items, distance)
+	if err := validateMatrix(d); err != nil {
+		return nil, err
+	}
+	return pam(d, config.withDefaults()), nil
+}
+
+// Clusters n items with k-medoids from a precomputed n x n distance matrix
+// A condensed matrix from vector.Pairwise can be expanded with its Square method.
+// ErrDimensionMismatch is returned if the matrix is not square, and ErrInvalidParameter if it holds
+// a negative or infinite distance.
+func KMedoidsMatrix(d vector.Matrix, config KMedoidsConfig) (*KMedoidsResult, error) {
+	if len(d) == 0 {
+		return nil, vector.ErrEmptyVector
+	}
+	if err := validateMatrix(d); err != nil {
+		return nil, err
+	}
+	if config.K < 1 || config.K > len(d) {
+		return nil, vector.ErrInvalidParameter
+	}
+	return pam(d, config.withDefaults()), nil
+}
+
+// Clusters items under an arbitrary distance with CLARA
+// CLARA runs k-medoids on Samples random subsets of SampleSize items, each including the best medoids
+// found so far, and keeps the medoids with the lowest cost over all the items. It needs
+// O(SampleSize^2 + n*K) distance computations per sample instead of the O(n^2) of KMedoids.
+// Like KMedoids, ErrInvalidParameter is returned if the distance function returns a negative or
+// infinite value, and ErrNaN if it returns NaN.
+// Reference: Kaufman and Rousseeuw, "Clustering Large Applications (Program CLARA)", 1990
+func CLARA[T any](items []T, distance func(a T, b T) float64, config KMedoidsConfig) (*KMedoidsResult, error) {
+	if len(items) == 0 {
+		return nil, vector.ErrEmptyVector
+	}
+	if config.K < 1 || config.K > len(items) {
+		return nil, vector.ErrInvalidParameter
+	}
+	config = config.withDefaults()
+	if config.SampleSize >= len(items) {
+		return KMedoids(items, distance, config)
+	}
+	if config.SampleSize < config.K {
+		return nil, vector.ErrInvalidParameter
+	}
+
+	rng := rand.New(rand.NewSource(config.Seed))
+	var best *KMedoidsResult
+	iterations := 0
+	for s := 0; s < config.Samples; s++ {
+		// Draw the sample, starting from the best medoids so far
+		chosen := make(map[int]bool, config.SampleSize)
+		sample := make([]int, 0, config.SampleSize)
+		if best != nil {
+			for _, m := range best.Medoids {
+				chosen[m] = true
+				sample = append(sample, m)
+			}
+		}
+		for _, i := range rng.Perm(len(items)) {
+			if len(sample) == config.SampleSize {
+				break
+			}
+			if !chosen[i] {
+				chosen[i] = true
+				sample = append(sample, i)
+			}
+		}
+
+		subset := make([]T, len(sample))
+		for i, index := range sample {
+			subset[i] = items[index]
+		}
+		d := distanceMatrix(subset, distance)
+		if err := validateMatrix(d); err != nil {
+			return nil, err
+		}
+		local := pam(d, config)
+
+		medoids := make([]int, len(local.Medoids))
+		for i, m := range local.Medoids {
+			medoids[i] = sample[m]
+		}
+		iterations += local.Iterations
+		result, err := assignAll(items, distance, medoids)
+		if err != nil {
+			return nil, err
+		}
+		if best == nil || result.Cost < best.Cost {
+			best = result
+		}
+	}
+	best.Iterations = iterations
+	return best, nil
+}
+
+// Assigns every item to its nearest medoid
+// The distances are checked like those of a distance matrix, see validateMatrix
+func assignAll[T any](items []T, distance func(a T, b T) float64, medoids []int) (*KMedoidsResult, error) {
+	sort.Ints(medoids)
+	result := &KMedoidsResult{Medoids: medoids, Labels: make([]int, len(items))}
+	for i, item := range items {
+		bestDist := math.Inf(1)
+		for c, m := range medoids {
+			d := 0.0
+			if i != m {
+				d = distance(item, items[m])
+			}
+			if math.IsNaN(d) {
+				return nil, vector.ErrNaN
+			}
+			if d < 0 || math.IsInf(d, 1) {
+				return nil, vector.ErrInvalidParameter
+			}
+			if d < bestDist {
+				result.Labels[i], bestDist = c, d
+			}
+		}
+		result.Cost += bestDist
+	}
+	return result, nil
+}
+
+// Computes the full symmetric matrix of distances between items
+func distanceMatrix[T any](items []T, distance func(a T, b T) float64) vector.Matrix {
+	n := len(items)
+	d := vector.NewMatrix(n, n)
+	for i := 0; i < n; i++ {
+		for j := i + 1; j < n; j++ {
+			d[i][j] = distance(items[i], items[j])
+			d[j][i] = d[i][j]
+		}
+	}
+	return d
+}
+
+// Validates a distance matrix
+// Distances must be finite and non-negative: an infinite distance leaves the cost of every medoid
+// choice infinite and a negative one rewards far assignments, so neither has a meaningful clustering.
+func validateMatrix(d vector.Matrix) error {
+	for _, row := range d {
+		if len(row) != len(d) {
+			return vector.ErrDimensionMismatch
+		}
+		for _, value := range row {
+			if math.IsNaN(value) {
+				return vector.ErrNaN
+			}
+			if value < 0 || math.IsInf(value, 1) {
+				return vector.ErrInvalidParameter
+			}
+		}
+	}
+	return nil
+}
+
+// medoidState tracks the nearest and second nearest medoids of every item
+type medoidState struct {
+	d       vector.Matrix
+	medoids []int
+	nearest []int
+	dn      []float64
+	ds      []float64
+}
+
+// Recomputes the nearest and second nearest medoids of every item
+func (s *medoidState) update() {
+	for o := range s.d {
+		s.nearest[o], s.dn[o], s.ds[o] = -1, math.Inf(1), math.Inf(1)
+		for c, m := range s.medoids {
+			if d := s.d[o][m]; d < s.dn[o] {
+				s.nearest[o], s.dn[o], s.ds[o] = c, d, s.dn[o]
+			} else if d < s.ds[o] {
+				s.ds[o] = d
+			}
+		}
+	}
+}
+
+// Runs the BUILD and SWAP phases of PAM on a distance matrix
+func pam(d vector.Matrix, config KMedoidsConfig) *KMedoidsResult {
+	n, k := len(d), config.K
+	s := &medoidState{d: d, nearest: make([]int, n), dn: make([]float64, n), ds: make([]float64, n)}
+
+	// BUILD: greedily add the item that lowers the total distance the most, starting from the most central one
+	isMedoid := make([]bool, n)
+	for o := range s.dn {
+		s.dn[o] = math.Inf(1)
+	}
+	for len(s.medoids) < k {
+		best, bestCost := -1, math.Inf(1)
+		for c := 0; c < n; c++ {
+			if isMedoid[c] {
+				continue
+			}
+			var cost float64 = 0
+			for o := 0; o < n; o++ {
+				cost += math.Min(s.dn[o], d[o][c])
+			}
+			if cost < bestCost {
+				best, bestCost = c, cost
+			}
+		}
+		isMedoid[best] = true
+		s.medoids = append(s.medoids, best)
+		for o := 0; o < n; o++ {
+			s.dn[o] = math.Min(s.dn[o], d[o][best])
+		}
+	}
+	s.update()
+
+	// SWAP: apply the best improving swap of a medoid with a non-medoid until none is left
+	iterations := 0
+	delta := make([]float64, k)
+	for ; iterations < config.MaxIterations; iterations++ {
+		bestDelta, bestMedoid, bestCandidate := 0.0, -1, -1
+		for c := 0; c < n; c++ {
+			if isMedoid[c] {
+				continue
+			}
+			if config.Method == PAM {
+				for m := 0; m < k; m++ {
+					delta[m] = s.swapCost(m, c)
+				}
+			} else {
+				s.swapCosts(c, delta)
+			}
+			for m, value := range delta {
+				if value < bestDelta {
+					bestDelta, bestMedoid, bestCandidate = value, m, c
+				}
+			}
+		}
+
+		// Stop unless the swap improves the cost by more than rounding noise
+		if bestMedoid < 0 || bestDelta > -1e-12*math.Max(1, math.Abs(s.cost())) {
+			break
+		}
+		isMedoid[s.medoids[bestMedoid]], isMedoid[bestCandidate] = false, true
+		s.medoids[bestMedoid] = bestCandidate
+		s.update()
+	}
+
+	// Report the medoids in increasing order
+	medoids := append([]int(nil), s.medoids...)
+	sort.Ints(medoids)
+	s.medoids = medoids
+	s.update()
+	return &KMedoidsResult{Medoids: medoids, Labels: s.nearest, Cost: s.cost(), Iterations: iterations}
+}
+
+// Computes the sum of the distances of every item to its nearest medoid
+func (s *medoidState) cost() float64 {
+	var result float64 = 0
+	for _, d := range s.dn {
+		result += d
+	}
+	return result
+}
+
+// Computes the change in total cost of replacing medoid m by candidate c, in O(n)
+func (s *medoidState) swapCost(m int, c int) float64 {
+	var result float64 = 0
+	for o := range s.d {
+		doc := s.d[o][c]
+		if s.nearest[o] == m {
+			result += math.Min(doc, s.ds[o]) - s.dn[o]
+		} else if doc < s.dn[o] {
+			result += doc - s.dn[o]
+		}
+	}
+	return result
+}
+
+// Computes the change in total cost of replacing each medoid by candidate c at once, in O(n + k)
+// An item gains from c whichever medoid is removed, so that shared gain is accumulated once and
+// only the loss of removing the item's own nearest medoid is tracked per medoid.
+func (s *medoidState) swapCosts(c int, delta []float64) {
+	for m := range delta {
+		delta[m] = 0
+	}
+	var shared float64 = 0
+	for o := range s.d {
+		doc, n := s.d[o][c], s.nearest[o]
+		delta[n] += math.Min(doc, s.ds[o]) - s.dn[o]
+		if doc < s.dn[o] {
+			shared += doc - s.dn[o]
+			delta[n] -= doc - s.dn[o]
+		}
+	}
+	for m := range delta {
+		delta[m] += shared
+	}
+}
@@ -0,0 +1,233 @@
+package cluster
+
+import (
+	"context"
+	"errors"
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/rexsimiloluwah/distance_metrics/text"
+	"github.com/rexsimiloluwah/distance_metrics/vector"
+)
+
+// Computes the square matrix of the pairwise distances between the rows
+func squareDistances(rows []vector.Vector, metric vector.DistanceFunc) vector.Matrix {
+	d, err := vector.Pairwise(context.Background(), rows, metric, 1)
+	if err != nil {
+		panic(err)
+	}
+	return d.Square()
+}
+
+// Computes the k-medoids cost of every k-subset of the items, returning the lowest
+func bruteForceCost(d vector.Matrix, k int) float64 {
+	best := math.Inf(1)
+	var search func(start int, medoids []int)
+	search = func(start int, medoids []int) {
+		if len(medoids) == k {
+			var cost float64 = 0
+			for o := range d {
+				nearest := math.Inf(1)
+				for _, m := range medoids {
+					nearest = math.Min(nearest, d[o][m])
+				}
+				cost += nearest
+			}
+			best = math.Min(best, cost)
+			return
+		}
+		for i := start; i < len(d); i++ {
+			search(i+1, append(medoids, i))
+		}
+	}
+	search(0, nil)
+	return best
+}
+
+func TestKMedoids(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	data, expected := blobs(rng, centres, 25, 1)
+
+	for _, method := range []Method{FastPAM, PAM} {
+		result, err := KMedoids(data, vector.Euclidean, KMedoidsConfig{K: 4, Method: method})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !samePartition(result.Labels, expected) {
+			t.Error("Test Failed, the planted clusters expected,", result.Labels, " received.")
+		}
+		for c, m := range result.Medoids {
+			if result.Labels[m] != c {
+				t.Error("Test Failed,", c, " expected,", result.Labels[m], " received.")
+			}
+		}
+
+		var cost float64 = 0
+		for i, label := range result.Labels {
+			cost += vector.Euclidean(data[i], data[result.Medoids[label]])
+		}
+		if math.Abs(cost-result.Cost) > floatDifferenceThresh {
+			t.Error("Test Failed,", cost, " expected,", result.Cost, " received.")
+		}
+	}
+}
+
+func TestKMedoidsMethodsAgree(t *testing.T) {
+	rng := rand.New(rand.NewSource(2))
+	for trial := 0; trial < 10; trial++ {
+		data := make([]vector.Vector, 30)
+		for i := range data {
+			data[i] = vector.Vector{rng.Float64(), rng.Float64(), rng.Float64()}
+		}
+		d := squareDistances(data, vector.Manhattan)
+
+		fast, err := KMedoidsMatrix(d, KMedoidsConfig{K: 3})
+		if err != nil {
+			t.Fatal(err)
+		}
+		slow, err := KMedoidsMatrix(d, KMedoidsConfig{K: 3, Method: PAM})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if math.Abs(fast.Cost-slow.Cost) > floatDifferenceThresh || fast.Iterations != slow.Iterations {
+			t.Error("Test Failed,", slow.Cost, slow.Iterations, " expected,", fast.Cost, fast.Iterations, " received.")
+		}
+	}
+}
+
+func TestKMedoidsNearOptimal(t *testing.T) {
+	rng := rand.New(rand.NewSource(3))
+	for trial := 0; trial < 10; trial++ {
+		data := make([]vector.Vector, 12)
+		for i := range data {
+			data[i] = vector.Vector{rng.Float64(), rng.Float64()}
+		}
+		d := squareDistances(data, vector.Euclidean)
+		result, err := KMedoidsMatrix(d, KMedoidsConfig{K: 3})
+		if err != nil {
+			t.Fatal(err)
+		}
+		// PAM is a local search, but on small inputs it almost always reaches the optimum
+		if optimum := bruteForceCost(d, 3); result.Cost > 1.05*optimum {
+			t.Error("Test Failed,", optimum, " expected,", result.Cost, " received.")
+		}
+	}
+}
+
+func TestKMedoidsStrings(t *testing.T) {
+	words := []string{"kitten", "sitten", "sitting", "mitten", "banana", "bandana", "cabana", "ananas"}
+	distance := func(a string, b string) float64 {
+		return float64(text.Levensthein(a, b))
+	}
+
+	result, err := KMedoids(words, distance, KMedoidsConfig{K: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []int{0, 0, 0, 0, 1, 1, 1, 1}
+	if !samePartition(result.Labels, expected) {
+		t.Error("Test Failed,", expected, " expected,", result.Labels, " received.")
+	}
+}
+
+func TestCLARA(t *testing.T) {
+	rng := rand.New(rand.NewSource(4))
+	data, expected := blobs(rng, centres, 250, 1)
+
+	result, err := CLARA(data, vector.Euclidean, KMedoidsConfig{K: 4, Seed: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !samePartition(result.Labels, expected) {
+		t.Error("Test Failed, the planted clusters expected,", result.Labels, " received.")
+	}
+	if len(result.Medoids) != 4 || len(result.Labels) != len(data) {
+		t.Error("Test Failed,", 4, len(data), " expected,", len(result.Medoids), len(result.Labels), " received.")
+	}
+
+	// The swaps of every sample are counted, and the first sample only depends on the seed
+	one, _ := CLARA(data, vector.Euclidean, KMedoidsConfig{K: 4, Seed: 1, Samples: 1})
+	if result.Iterations < one.Iterations || one.Iterations == 0 {
+		t.Error("Test Failed, at least", one.Iterations, " expected,", result.Iterations, " received.")
+	}
+
+	// With a sample covering every item CLARA is plain k-medoids
+	small := data[:40]
+	clara, _ := CLARA(small, vector.Euclidean, KMedoidsConfig{K: 4, SampleSize: 40})
+	pam, _ := KMedoids(small, vector.Euclidean, KMedoidsConfig{K: 4})
+	if math.Abs(clara.Cost-pam.Cost) > floatDifferenceThresh {
+		t.Error("Test Failed,", pam.Cost, " expected,", clara.Cost, " received.")
+	}
+}
+
+func TestCLARAInvalidDistances(t *testing.T) {
+	items := make([]vector.Vector, 50)
+	for i := range items {
+		items[i] = vector.Vector{float64(i)}
+	}
+	var tests = []struct {
+		bad      float64
+		expected error
+	}{
+		{-1, vector.ErrInvalidParameter},
+		{math.Inf(1), vector.ErrInvalidParameter},
+		{math.NaN(), vector.ErrNaN},
+	}
+
+	for _, test := range tests {
+		// Only the distances from the last item are invalid, and with this seed it is not sampled
+		distance := func(a vector.Vector, b vector.Vector) float64 {
+			if a[0] == 49 || b[0] == 49 {
+				return test.bad
+			}
+			return vector.Euclidean(a, b)
+		}
+		if _, err := CLARA(items, distance, KMedoidsConfig{K: 2, SampleSize: 10, Samples: 1, Seed: 2}); !errors.Is(err, test.expected) {
+			t.Error("Test Failed,", test.expected, " expected,", err, " received.")
+		}
+	}
+}
+
+func TestKMedoidsErrors(t *testing.T) {
+	data := []vector.Vector{{0}, {1}, {2}}
+	var tests = []struct {
+		err      error
+		expected error
+	}{
+		{func() error {
+			_, err := KMedoids([]vector.Vector{}, vector.Euclidean, KMedoidsConfig{K: 1})
+			return err
+		}(), vector.ErrEmptyVector},
+		{func() error { _, err := KMedoids(data, vector.Euclidean, KMedoidsConfig{K: 0}); return err }(), vector.ErrInvalidParameter},
+		{func() error { _, err := KMedoids(data, vector.Euclidean, KMedoidsConfig{K: 4}); return err }(), vector.ErrInvalidParameter},
+		{func() error { _, err := KMedoidsMatrix(vector.Matrix{{0, 1}}, KMedoidsConfig{K: 1}); return err }(), vector.ErrDimensionMismatch},
+		{func() error {
+			_, err := KMedoidsMatrix(vector.Matrix{{0, math.NaN()}, {1, 0}}, KMedoidsConfig{K: 1})
+			return err
+		}(), vector.ErrNaN},
+		{func() error {
+			_, err := KMedoidsMatrix(vector.Matrix{{0, -5}, {-5, 0}}, KMedoidsConfig{K: 1})
+			return err
+		}(), vector.ErrInvalidParameter},
+		{func() error {
+			inf := math.Inf(1)
+			_, err := KMedoidsMatrix(vector.Matrix{{0, inf, inf}, {inf, 0, inf}, {inf, inf, 0}}, KMedoidsConfig{K: 2})
+			return err
+		}(), vector.ErrInvalidParameter},
+		{func() error {
+			_, err := KMedoids(data, func(a vector.Vector, b vector.Vector) float64 { return math.Inf(1) }, KMedoidsConfig{K: 2})
+			return err
+		}(), vector.ErrInvalidParameter},
+		{func() error {
+			_, err := CLARA(data, vector.Euclidean, KMedoidsConfig{K: 2, SampleSize: 1})
+			return err
+		}(), vector.ErrInvalidParameter},
+	}
+
+	for _, test := range tests {
+		if !errors.Is(test.err, test.expected) {
+			t.Error("Test Failed,", test.expected, " expected,", test.err, " received.")
+		}
+	}
+}
@@ -0,0 +1,301 @@
+package distribution
+
+import (
+	"errors"
+	"math"
+
+	"github.com/rexsimiloluwah/distance_metrics/vector"
+)
+
+// Sentinel errors returned when the inputs are not valid probability distributions
+// Empty, mismatched and NaN inputs are reported with the errors of the vector package
+var (
+	ErrNegativeProbability = errors.New("distribution: probabilities cannot be negative")
+	ErrNotNormalized       = errors.New("distribution: probabilities must sum to 1")
+)
+
+// Default tolerance used when checking that a distribution sums to 1
+const defaultSumTolerance = 1e-6
+
+// Options controls how input vectors are turned into probability distributions
+// The zero value requires both inputs to already be non-negative and sum to 1
+type Options struct {
+	// Normalize divides each input by its sum instead of requiring it to sum to 1
+	Normalize bool
+	// Epsilon is added to every probability (before renormalising) so that
+	// zero entries do not make the log-based divergences infinite
+	Epsilon float64
+	// Tolerance is the largest difference from 1 accepted for the sum of an input that is not normalised, 1e-6 if not set
+	Tolerance float64
+}
+
+// Validates the inputs and applies the normalisation and smoothing options
+func (o Options) prepare(p vector.Vector, q vector.Vector) (vector.Vector, vector.Vector, error) {
+	if p.Length() == 0 || q.Length() == 0 {
+		return nil, nil, vector.ErrEmptyVector
+	}
+	if p.Length() != q.Length() {
+		return nil, nil, vector.ErrDimensionMismatch
+	}
+	if o.Epsilon < 0 || math.IsNaN(o.Epsilon) || o.Tolerance < 0 || math.IsNaN(o.Tolerance) {
+		return nil, nil, vector.ErrInvalidParameter
+	}
+
+	p, err := o.distribution(p)
+	if err != nil {
+		return nil, nil, err
+	}
+	q, err = o.distribution(q)
+	if err != nil {
+		return nil, nil, err
+	}
+	return p, q, nil
+}
+
+func (o Options) distribution(v vector.Vector) (vector.Vector, error) {
+	var sum float64 = 0
+	for _, value := range v {
+		if math.IsNaN(value) {
+			return nil, vector.ErrNaN
+		}
+		if value < 0 {
+			return nil, ErrNegativeProbability
+		}
+		sum += value
+	}
+
+	tolerance := o.Tolerance
+	if tolerance == 0 {
+		tolerance = defaultSumTolerance
+	}
+	if !o.Normalize && math.Abs(sum-1) > tolerance {
+		return nil, ErrNotNormalized
+	}
+	if sum == 0 {
+		return nil, ErrNotNormalized
+	}
+	if !o.Normalize && o.Epsilon == 0 {
+		return v, nil
+	}
+
+	total := sum + o.Epsilon*float64(v.Length())
+	result := make(vector.Vector, v.Length())
+	for i, value := range v {
+		result[i] = (value + o.Epsilon) / total
+	}
+	return result, nil
+}
+
+// Computes the Kullback-Leibler divergence KL(p || q) = sum(p_i * ln(p_i / q_i)) in nats
+// The divergence is asymmetric and is +Inf if q_i = 0 for some p_i > 0
+// Reference: https://en.wikipedia.org/wiki/Kullback%E2%80%93Leibler_divergence
+func (o Options) KullbackLeibler(p vector.Vector, q vector.Vector) (float64, error) {
+	p, q, err := o.prepare(p, q)
+	if err != nil {
+		return 0, err
+	}
+	return kl(p, q), nil
+}
+
+// Computes the symmetric (Jeffreys) divergence KL(p || q) + KL(q || p)
+func (o Options) SymmetricKullbackLeibler(p vector.Vector, q vector.Vector) (float64, error) {
+	p, q, err := o.prepare(p, q)
+	if err != nil {
+		return 0, err
+	}
+	return kl(p, q) + kl(q, p), nil
+}
+
+// Computes the Jensen-Shannon divergence in nats
+// JS(p,q) = 1/2 * KL(p || m) + 1/2 * KL(q || m), where m = (p + q) / 2
+// The divergence is symmetric, always finite and ranges between 0 and ln(2)
+// Reference: https://en.wikipedia.org/wiki/Jensen%E2%80%93Shannon_divergence
+func (o Options) JensenShannonDivergence(p vector.Vector, q vector.Vector) (float64, error) {
+	p, q, err := o.prepare(p, q)
+	if err != nil {
+		return 0, err
+	}
+
+	m := make(vector.Vector, p.Length())
+	for i := range m {
+		m[i] = (p[i] + q[i]) / 2
+	}
+	// Rounding can make the divergence very slightly negative for identical inputs
+	return math.Max(0, (kl(p, m)+kl(q, m))/2), nil
+}
+
+// Computes the Jensen-Shannon distance, the square root of the Jensen-Shannon divergence
+// Unlike the divergence, the distance is a true metric
+func (o Options) JensenShannonDistance(p vector.Vector, q vector.Vector) (float64, error) {
+	d, err := o.JensenShannonDivergence(p, q)
+	if err != nil {
+		return 0, err
+	}
+	return math.Sqrt(d), nil
+}
+
+// Computes the Hellinger distance
+// Hellinger(p,q) = 1/sqrt(2) * sqrt(sum((sqrt(p_i) - sqrt(q_i))^2))
+// The distance is a true metric and ranges between 0 and 1
+// Reference: https://en.wikipedia.org/wiki/Hellinger_distance
+func (o Options) Hellinger(p vector.Vector, q vector.Vector) (float64, error) {
+	p, q, err := o.prepare(p, q)
+	if err != nil {
+		return 0, err
+	}
+
+	var result float64 = 0
+	for i := range p {
+		d := math.Sqrt(p[i]) - math.Sqrt(q[i])
+		result += d * d
+	}
+	return math.Sqrt(result / 2), nil
+}
+
+// Computes the Bhattacharyya coefficient sum(sqrt(p_i * q_i))
+// The coefficient measures the overlap of the distributions and ranges between 0 and 1
+// Reference: https://en.wikipedia.org/wiki/Bhattacharyya_distance
+func (o Options) BhattacharyyaCoefficient(p vector.Vector, q vector.Vector) (float64, error) {
+	p, q, err := o.prepare(p, q)
+	if err != nil {
+		return 0, err
+	}
+	return bc(p, q), nil
+}
+
+// Computes the Bhattacharyya distance -ln(BhattacharyyaCoefficient(p,q))
+// The distance is +Inf for distributions with disjoint support
+func (o Options) BhattacharyyaDistance(p vector.Vector, q vector.Vector) (float64, error) {
+	p, q, err := o.prepare(p, q)
+	if err != nil {
+		return 0, err
+	}
+	return math.Max(0, -math.Log(bc(p, q))), nil
+}
+
+// Computes the total variation distance 1/2 * sum(|p_i - q_i|)
+// The distance ranges between 0 and 1
+// Reference: https://en.wikipedia.org/wiki/Total_variation_distance_of_probability_measures
+func (o Options) TotalVariation(p vector.Vector, q vector.Vector) (float64, error) {
+	p, q, err := o.prepare(p, q)
+	if err != nil {
+		return 0, err
+	}
+
+	var result float64 = 0
+	for i := range p {
+		result += math.Abs(p[i] - q[i])
+	}
+	return result / 2, nil
+}
+
+func kl(p vector.Vector, q vector.Vector) float64 {
+	var result float64 = 0
+	for i := range p {
+		if p[i] == 0 {
+			continue
+		}
+		if q[i] == 0 {
+			return math.Inf(1)
+		}
+		result += p[i] * math.Log(p[i]/q[i])
+	}
+	return result
+}
+
+func bc(p vector.Vector, q vector.Vector) float64 {
+	var result float64 = 0
+	for i := range p {
+		result += math.Sqrt(p[i] * q[i])
+	}
+	return math.Min(1, result)
+}
+
+/* Package-level functions using the default (strict) options */
+
+// Computes the Kullback-Leibler divergence KL(p || q) between two distributions
+func KullbackLeibler(p vector.Vector, q vector.Vector) float64 {
+	return must(Options{}.KullbackLeibler(p, q))
+}
+
+// Computes the Kullback-Leibler divergence, returning an error on invalid input
+func TryKullbackLeibler(p vector.Vector, q vector.Vector) (float64, error) {
+	return Options{}.KullbackLeibler(p, q)
+}
+
+// Computes the symmetric Kullback-Leibler divergence between two distributions
+func SymmetricKullbackLeibler(p vector.Vector, q vector.Vector) float64 {
+	return must(Options{}.SymmetricKullbackLeibler(p, q))
+}
+
+// Computes the symmetric Kullback-Leibler divergence, returning an error on invalid input
+func TrySymmetricKullbackLeibler(p vector.Vector, q vector.Vector) (float64, error) {
+	return Options{}.SymmetricKullbackLeibler(p, q)
+}
+
+// Computes the Jensen-Shannon divergence between two distributions
+func JensenShannonDivergence(p vector.Vector, q vector.Vector) float64 {
+	return must(Options{}.JensenShannonDivergence(p, q))
+}
+
+// Computes the Jensen-Shannon divergence, returning an error on invalid input
+func TryJensenShannonDivergence(p vector.Vector, q vector.Vector) (float64, error) {
+	return Options{}.JensenShannonDivergence(p, q)
+}
+
+// Computes the Jensen-Shannon distance between two distributions
+func JensenShannonDistance(p vector.Vector, q vector.Vector) float64 {
+	return must(Options{}.JensenShannonDistance(p, q))
+}
+
+// Computes the Jensen-Shannon distance, returning an error on invalid input
+func TryJensenShannonDistance(p vector.Vector, q vector.Vector) (float64, error) {
+	return Options{}.JensenShannonDistance(p, q)
+}
+
+// Computes the Hellinger distance between two distributions
+func Hellinger(p vector.Vector, q vector.Vector) float64 {
+	return must(Options{}.Hellinger(p, q))
+}
+
+// Computes the Hellinger distance, returning an error on invalid input
+func TryHellinger(p vector.Vector, q vector.Vector) (float64, error) {
+	return Options{}.Hellinger(p, q)
+}
+
+// Computes the Bhattacharyya coefficient between two distributions
+func BhattacharyyaCoefficient(p vector.Vector, q vector.Vector) float64 {
+	return must(Options{}.BhattacharyyaCoefficient(p, q))
+}
+
+// Computes the Bhattacharyya coefficient, returning an error on invalid input
+func TryBhattacharyyaCoefficient(p vector.Vector, q vector.Vector) (float64, error) {
+	return Options{}.BhattacharyyaCoefficient(p, q)
+}
+
+// Computes the Bhattacharyya distance between two distributions
+func BhattacharyyaDistance(p vector.Vector, q vector.Vector) float64 {
+	return must(Options{}.BhattacharyyaDistance(p, q))
+}
+
+// Computes the Bhattacharyya distance, returning an error on invalid input
+func TryBhattacharyyaDistance(p vector.Vector, q vector.Vector) (float64, error) {
+	return Options{}.BhattacharyyaDistance(p, q)
+}
+
+// Computes the total variation distance between two distributions
+func TotalVariation(p vector.Vector, q vector.Vector) float64 {
+	return must(Options{}.TotalVariation(p, q))
+}
+
+// Computes the total variation distance, returning an error on invalid input
+func TryTotalVariation(p vector.Vector, q vector.Vector) (float64, error) {
+	return Options{}.TotalVariation(p, q)
+}
+
+func must(result float64, err error) float64 {
+	if err != nil {
+		panic(err)
+	}
+	return result
+}
@@ -0,0 +1,136 @@
+package distribution
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/rexsimiloluwah/distance_metrics/vector"
+)
+
+var floatDifferenceThresh float64 = 1e-4
+
+func TestKullbackLeibler(t *testing.T) {
+	var tests = []struct {
+		p        vector.Vector
+		q        vector.Vector
+		expected float64
+	}{
+		{vector.Vector{0.5, 0.5}, vector.Vector{0.5, 0.5}, 0},
+		{vector.Vector{0.36, 0.48, 0.16}, vector.Vector{1.0 / 3, 1.0 / 3, 1.0 / 3}, 0.0853},
+		{vector.Vector{1.0 / 3, 1.0 / 3, 1.0 / 3}, vector.Vector{0.36, 0.48, 0.16}, 0.0975},
+		{vector.Vector{0.5, 0.5}, vector.Vector{1, 0}, math.Inf(1)},
+	}
+
+	for _, test := range tests {
+		if output := KullbackLeibler(test.p, test.q); !(output == test.expected || math.Abs(output-test.expected) < floatDifferenceThresh) {
+			t.Error("Test Failed,", test.expected, " expected,", output, " received.")
+		}
+	}
+
+	if output := SymmetricKullbackLeibler(tests[1].p, tests[1].q); math.Abs(output-(0.0853+0.0975)) > floatDifferenceThresh {
+		t.Error("Test Failed,", 0.0853+0.0975, " expected,", output, " received.")
+	}
+}
+
+func TestJensenShannon(t *testing.T) {
+	var tests = []struct {
+		p        vector.Vector
+		q        vector.Vector
+		expected float64
+	}{
+		{vector.Vector{0.5, 0.5}, vector.Vector{0.5, 0.5}, 0},
+		{vector.Vector{1, 0}, vector.Vector{0, 1}, math.Ln2},
+		{vector.Vector{1, 0}, vector.Vector{0.5, 0.5}, 0.2158},
+	}
+
+	for _, test := range tests {
+		if output := JensenShannonDivergence(test.p, test.q); math.Abs(output-test.expected) > floatDifferenceThresh {
+			t.Error("Test Failed,", test.expected, " expected,", output, " received.")
+		}
+		if output := JensenShannonDistance(test.p, test.q); math.Abs(output-math.Sqrt(test.expected)) > floatDifferenceThresh {
+			t.Error("Test Failed,", math.Sqrt(test.expected), " expected,", output, " received.")
+		}
+	}
+}
+
+func TestHellingerBhattacharyya(t *testing.T) {
+	var tests = []struct {
+		p           vector.Vector
+		q           vector.Vector
+		coefficient float64
+	}{
+		{vector.Vector{0.5, 0.5}, vector.Vector{0.5, 0.5}, 1},
+		{vector.Vector{0.2, 0.8}, vector.Vector{0.8, 0.2}, 0.8},
+		{vector.Vector{1, 0}, vector.Vector{0, 1}, 0},
+	}
+
+	for _, test := range tests {
+		if output := BhattacharyyaCoefficient(test.p, test.q); math.Abs(output-test.coefficient) > floatDifferenceThresh {
+			t.Error("Test Failed,", test.coefficient, " expected,", output, " received.")
+		}
+		expected := math.Sqrt(1 - test.coefficient)
+		if output := Hellinger(test.p, test.q); math.Abs(output-expected) > floatDifferenceThresh {
+			t.Error("Test Failed,", expected, " expected,", output, " received.")
+		}
+		expected = -math.Log(test.coefficient)
+		if output := BhattacharyyaDistance(test.p, test.q); !(output == expected || math.Abs(output-expected) < floatDifferenceThresh) {
+			t.Error("Test Failed,", expected, " expected,", output, " received.")
+		}
+	}
+}
+
+func TestTotalVariation(t *testing.T) {
+	var tests = []struct {
+		p        vector.Vector
+		q        vector.Vector
+		expected float64
+	}{
+		{vector.Vector{0.5, 0.5}, vector.Vector{0.5, 0.5}, 0},
+		{vector.Vector{1, 0}, vector.Vector{0, 1}, 1},
+		{vector.Vector{0.2, 0.3, 0.5}, vector.Vector{0.1, 0.6, 0.3}, 0.3},
+	}
+
+	for _, test := range tests {
+		if output := TotalVariation(test.p, test.q); math.Abs(output-test.expected) > floatDifferenceThresh {
+			t.Error("Test Failed,", test.expected, " expected,", output, " received.")
+		}
+	}
+}
+
+func TestOptions(t *testing.T) {
+	// Auto-normalisation of raw counts
+	output, err := Options{Normalize: true}.TotalVariation(vector.Vector{2, 3, 5}, vector.Vector{1, 6, 3})
+	if err != nil || math.Abs(output-0.3) > floatDifferenceThresh {
+		t.Error("Test Failed,", 0.3, " expected,", output, err, " received.")
+	}
+
+	// Epsilon smoothing keeps the divergence finite
+	output, err = Options{Epsilon: 1e-3}.KullbackLeibler(vector.Vector{0.5, 0.5}, vector.Vector{1, 0})
+	if err != nil || math.IsInf(output, 1) || output <= 0 {
+		t.Error("Test Failed, finite positive divergence expected,", output, err, " received.")
+	}
+
+	var tests = []struct {
+		opts     Options
+		p        vector.Vector
+		q        vector.Vector
+		expected error
+	}{
+		{Options{}, vector.Vector{2, 3, 5}, vector.Vector{0.2, 0.3, 0.5}, ErrNotNormalized},
+		{Options{}, vector.Vector{1.5, -0.5}, vector.Vector{0.5, 0.5}, ErrNegativeProbability},
+		{Options{Normalize: true}, vector.Vector{0, 0}, vector.Vector{0.5, 0.5}, ErrNotNormalized},
+		{Options{}, vector.Vector{1}, vector.Vector{0.5, 0.5}, vector.ErrDimensionMismatch},
+		{Options{}, vector.Vector{}, vector.Vector{}, vector.ErrEmptyVector},
+		{Options{Epsilon: -1}, vector.Vector{1}, vector.Vector{1}, vector.ErrInvalidParameter},
+		{Options{Tolerance: -1}, vector.Vector{1}, vector.Vector{1}, vector.ErrInvalidParameter},
+		{Options{}, vector.Vector{0.5, 0.499}, vector.Vector{0.5, 0.5}, ErrNotNormalized},
+		{Options{Tolerance: 1e-2}, vector.Vector{0.5, 0.499}, vector.Vector{0.5, 0.5}, nil},
+	}
+
+	for _, test := range tests {
+		if _, err := test.opts.Hellinger(test.p, test.q); !errors.Is(err, test.expected) {
+			t.Error("Test Failed,", test.expected, " expected,", err, " received.")
+		}
+	}
+}
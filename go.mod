@@ -1,3 +1,3 @@
 module github.com/rexsimiloluwah/distance_metrics
 
-go 1.17
+go 1.18
@@ -0,0 +1,179 @@
+package lsh
+
+import (
+	"math"
+	"math/bits"
+	"math/rand"
+	"sort"
+
+	"github.com/rexsimiloluwah/distance_metrics/vector"
+)
+
+// BitSignature is the packed bit sketch of a vector computed by Hyperplanes
+// Bit i of the signature is bit i%64 of word i/64.
+type BitSignature []uint64
+
+// Computes the number of bit positions in which two signatures differ
+func Hamming(a BitSignature, b BitSignature) int {
+	result, err := TryHamming(a, b)
+	if err != nil {
+		panic(err)
+	}
+	return result
+}
+
+// Computes the number of bit positions in which two signatures differ, returning an error if their lengths differ
+func TryHamming(a BitSignature, b BitSignature) (int, error) {
+	if len(a) != len(b) {
+		return 0, vector.ErrDimensionMismatch
+	}
+
+	count := 0
+	for i := range a {
+		count += bits.OnesCount64(a[i] ^ b[i])
+	}
+	return count, nil
+}
+
+// Returns the bits [from, from+n) of the signature as an integer, n <= 64
+func (s BitSignature) bits(from int, n int) uint64 {
+	var result uint64 = 0
+	for i := 0; i < n; i++ {
+		b := from + i
+		result |= ((s[b/64] >> (b % 64)) & 1) << i
+	}
+	return result
+}
+
+// Hyperplanes hashes vectors to bit signatures with random hyperplanes (SimHash)
+// Bit i of a signature records on which side of the i-th random hyperplane the vector lies,
+// so two vectors at angle theta disagree on each bit with probability theta / pi.
+// Reference: Charikar, "Similarity Estimation Techniques from Rounding Algorithms"
+type Hyperplanes struct {
+	planes []vector.Vector
+}
+
+// Initialize a set of random hyperplanes for dim-dimensional vectors producing signatures of the given number of bits
+// The seed makes the hyperplanes, and so the signatures, reproducible
+func NewHyperplanes(dim int, bits int, seed int64) (*Hyperplanes, error) {
+	if dim <= 0 || bits <= 0 {
+		return nil, vector.ErrInvalidParameter
+	}
+
+	rng := rand.New(rand.NewSource(seed))
+	planes := make([]vector.Vector, bits)
+	for i := range planes {
+		planes[i] = make(vector.Vector, dim)
+		for j := range planes[i] {
+			planes[i][j] = rng.NormFloat64()
+		}
+	}
+	return &Hyperplanes{planes: planes}, nil
+}
+
+// Returns the number of bits in each signature
+func (h *Hyperplanes) Bits() int {
+	return len(h.planes)
+}
+
+// Computes the bit signature of a vector
+func (h *Hyperplanes) Signature(v vector.Vector) BitSignature {
+	sig, err := h.TrySignature(v)
+	if err != nil {
+		panic(err)
+	}
+	return sig
+}
+
+// Computes the bit signature of a vector, returning an error if its dimension does not match the hyperplanes
+func (h *Hyperplanes) TrySignature(v vector.Vector) (BitSignature, error) {
+	if v.Length() != h.planes[0].Length() {
+		return nil, vector.ErrDimensionMismatch
+	}
+
+	sig := make(BitSignature, (len(h.planes)+63)/64)
+	for i, plane := range h.planes {
+		if plane.Dot(v) >= 0 {
+			sig[i/64] |= 1 << (i % 64)
+		}
+	}
+	return sig, nil
+}
+
+// Estimates the angle (in radians) between two vectors from their signatures
+func (h *Hyperplanes) EstimateAngle(a BitSignature, b BitSignature) float64 {
+	return math.Pi * float64(Hamming(a, b)) / float64(len(h.planes))
+}
+
+// Estimates the cosine similarity between two vectors from their signatures
+func (h *Hyperplanes) EstimateCosine(a BitSignature, b BitSignature) float64 {
+	return math.Cos(h.EstimateAngle(a, b))
+}
+
+// CosineIndex is a banded LSH index for retrieving candidate neighbours under cosine similarity
+// Each signature is split into bands of rows bits and vectors sharing all bits of any band are candidates.
+// More rows per band make candidates more precise, more bands make it less likely to miss a neighbour;
+// the exact similarity of the candidates should then be checked, e.g. with vector.CosineSimilarity.
+// Add must not be called concurrently with other methods.
+type CosineIndex struct {
+	hasher *Hyperplanes
+	bands  int
+	rows   int
+	tables []map[uint64][]int
+}
+
+// Initialize a new empty index for dim-dimensional vectors using bands bands of rows bits each (rows <= 64)
+func NewCosineIndex(dim int, bands int, rows int, seed int64) (*CosineIndex, error) {
+	if bands <= 0 || rows <= 0 || rows > 64 {
+		return nil, vector.ErrInvalidParameter
+	}
+
+	hasher, err := NewHyperplanes(dim, bands*rows, seed)
+	if err != nil {
+		return nil, err
+	}
+	tables := make([]map[uint64][]int, bands)
+	for i := range tables {
+		tables[i] = make(map[uint64][]int)
+	}
+	return &CosineIndex{hasher: hasher, bands: bands, rows: rows, tables: tables}, nil
+}
+
+// Returns the hyperplanes used to compute the signatures of the index
+func (c *CosineIndex) Hasher() *Hyperplanes {
+	return c.hasher
+}
+
+// Add a vector with the given ID to the index
+// ErrDimensionMismatch is returned if the vector does not have the dimension of the index
+func (c *CosineIndex) Add(id int, v vector.Vector) error {
+	sig, err := c.hasher.TrySignature(v)
+	if err != nil {
+		return err
+	}
+	for b := range c.tables {
+		key := sig.bits(b*c.rows, c.rows)
+		c.tables[b][key] = append(c.tables[b][key], id)
+	}
+	return nil
+}
+
+// Returns the sorted IDs of the vectors sharing at least one band with the query
+func (c *CosineIndex) Candidates(query vector.Vector) ([]int, error) {
+	sig, err := c.hasher.TrySignature(query)
+	if err != nil {
+		return nil, err
+	}
+	seen := map[int]bool{}
+	result := []int{}
+	for b := range c.tables {
+		for _, id := range c.tables[b][sig.bits(b*c.rows, c.rows)] {
+			if !seen[id] {
+				seen[id] = true
+				result = append(result, id)
+			}
+		}
+	}
+	sort.Ints(result)
+	return result, nil
+}
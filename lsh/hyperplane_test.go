@@ -0,0 +1,103 @@
+package lsh
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/rexsimiloluwah/distance_metrics/vector"
+)
+
+func TestHamming(t *testing.T) {
+	var tests = []struct {
+		a        BitSignature
+		b        BitSignature
+		expected int
+	}{
+		{BitSignature{0}, BitSignature{0}, 0},
+		{BitSignature{0xFF}, BitSignature{0x0F}, 4},
+		{BitSignature{math.MaxUint64, 1}, BitSignature{0, 0}, 65},
+	}
+
+	for _, test := range tests {
+		if output := Hamming(test.a, test.b); output != test.expected {
+			t.Error("Test Failed,", test.expected, " expected,", output, " received.")
+		}
+	}
+}
+
+func TestEstimateAngle(t *testing.T) {
+	h, _ := NewHyperplanes(3, 4096, 1)
+	var tests = []struct {
+		a vector.Vector
+		b vector.Vector
+	}{
+		{vector.Vector{1, 0, 0}, vector.Vector{0, 1, 0}},
+		{vector.Vector{1, 2, 3}, vector.Vector{1, 2, 2.5}},
+		{vector.Vector{1, 0, 0}, vector.Vector{-1, 0.1, 0}},
+	}
+
+	for _, test := range tests {
+		expected := math.Acos(vector.CosineSimilarity(test.a, test.b))
+		output := h.EstimateAngle(h.Signature(test.a), h.Signature(test.b))
+		if math.Abs(output-expected) > 0.1 {
+			t.Error("Test Failed,", expected, " expected,", output, " received.")
+		}
+	}
+
+	// Scaling a vector does not change its signature
+	sig := h.Signature(vector.Vector{1, 2, 3})
+	if Hamming(sig, h.Signature(vector.Vector{2, 4, 6})) != 0 {
+		t.Error("Test Failed, identical signatures expected for parallel vectors")
+	}
+}
+
+func TestCosineIndex(t *testing.T) {
+	rng := rand.New(rand.NewSource(3))
+	index, err := NewCosineIndex(16, 20, 8, 7)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	base := make(vector.Vector, 16)
+	for i := range base {
+		base[i] = rng.NormFloat64()
+	}
+	// Vector 0 is a small perturbation of the query, the others are random
+	near := make(vector.Vector, 16)
+	for i := range near {
+		near[i] = base[i] + 0.05*rng.NormFloat64()
+	}
+	index.Add(0, near)
+	for id := 1; id < 200; id++ {
+		v := make(vector.Vector, 16)
+		for i := range v {
+			v[i] = rng.NormFloat64()
+		}
+		index.Add(id, v)
+	}
+
+	candidates, err := index.Candidates(base)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(candidates) == 0 || candidates[0] != 0 {
+		t.Error("Test Failed, candidate 0 expected,", candidates, " received.")
+	}
+	if len(candidates) > 50 {
+		t.Error("Test Failed, a selective candidate set expected,", len(candidates), " candidates received.")
+	}
+
+	if _, err := NewCosineIndex(16, 2, 65, 1); err != vector.ErrInvalidParameter {
+		t.Error("Test Failed,", vector.ErrInvalidParameter, " expected,", err, " received.")
+	}
+	if err := index.Add(200, vector.Vector{1, 2}); err != vector.ErrDimensionMismatch {
+		t.Error("Test Failed,", vector.ErrDimensionMismatch, " expected,", err, " received.")
+	}
+	if _, err := index.Candidates(vector.Vector{1, 2}); err != vector.ErrDimensionMismatch {
+		t.Error("Test Failed,", vector.ErrDimensionMismatch, " expected,", err, " received.")
+	}
+	if _, err := TryHamming(BitSignature{0}, BitSignature{0, 0}); err != vector.ErrDimensionMismatch {
+		t.Error("Test Failed,", vector.ErrDimensionMismatch, " expected,", err, " received.")
+	}
+}
@@ -0,0 +1,277 @@
+package lsh
+
+import (
+	"math"
+	"math/bits"
+	"math/rand"
+	"sort"
+
+	"github.com/rexsimiloluwah/distance_metrics/set"
+	"github.com/rexsimiloluwah/distance_metrics/vector"
+)
+
+// The Mersenne prime 2^61 - 1 used as the modulus of the MinHash permutations
+const mersenne61 uint64 = 1<<61 - 1
+
+// The signature value of an empty set, larger than any hash value
+const emptyHash uint64 = math.MaxUint64
+
+// MinHashSignature holds the minimum hash values of a set under each permutation of a MinHasher
+type MinHashSignature []uint64
+
+// MinHasher computes MinHash signatures of sets for estimating their Jaccard similarity
+// Each of the permutations is simulated by a universal hash h(x) = (a*x + b) mod (2^61 - 1),
+// and a signature stores the minimum hash of the set's elements under each of them. The probability
+// that two sets share a minimum is their Jaccard similarity, so the fraction of equal signature
+// values is an unbiased estimate of it, without computing the union or intersection of the sets.
+// Reference: https://en.wikipedia.org/wiki/MinHash
+type MinHasher struct {
+	a []uint64
+	b []uint64
+}
+
+// Initialize a new MinHasher with the given number of permutations
+// The seed makes the permutations, and so the signatures, reproducible
+func NewMinHasher(permutations int, seed int64) (*MinHasher, error) {
+	if permutations <= 0 {
+		return nil, vector.ErrInvalidParameter
+	}
+
+	rng := rand.New(rand.NewSource(seed))
+	m := &MinHasher{a: make([]uint64, permutations), b: make([]uint64, permutations)}
+	for i := 0; i < permutations; i++ {
+		m.a[i] = 1 + uint64(rng.Int63n(int64(mersenne61-1)))
+		m.b[i] = uint64(rng.Int63n(int64(mersenne61)))
+	}
+	return m, nil
+}
+
+// Returns the number of values in each signature
+func (m *MinHasher) Permutations() int {
+	return len(m.a)
+}
+
+// Computes the MinHash signature of a set
+func (m *MinHasher) Signature(s *set.Set) MinHashSignature {
+	return m.SignatureOf(s.ToArray())
+}
+
+// Computes the MinHash signature of the distinct elements of a slice
+func (m *MinHasher) SignatureOf(elements []float64) MinHashSignature {
+	sig := make(MinHashSignature, len(m.a))
+	for i := range sig {
+		sig[i] = emptyHash
+	}
+
+	for _, el := range elements {
+		if el != el {
+			continue // NaN is never equal to itself, so it cannot be shared between sets
+		}
+		if el == 0 {
+			el = 0 // -0 and +0 are the same set element
+		}
+		x := mix64(math.Float64bits(el)) % mersenne61
+		for i := range sig {
+			if h := (mulmod61(m.a[i], x) + m.b[i]) % mersenne61; h < sig[i] {
+				sig[i] = h
+			}
+		}
+	}
+	return sig
+}
+
+// Estimates the Jaccard similarity of two sets from their MinHash signatures
+// Like set.Jaccard, the similarity of two empty sets is 0, while set.JaccardDistance treats them as identical
+func EstimateJaccard(a MinHashSignature, b MinHashSignature) float64 {
+	result, err := TryEstimateJaccard(a, b)
+	if err != nil {
+		panic(err)
+	}
+	return result
+}
+
+// Estimates the Jaccard similarity of two sets from their MinHash signatures, returning an error if their lengths differ
+func TryEstimateJaccard(a MinHashSignature, b MinHashSignature) (float64, error) {
+	if len(a) != len(b) {
+		return 0, vector.ErrDimensionMismatch
+	}
+	if len(a) == 0 || (a[0] == emptyHash && b[0] == emptyHash) {
+		return 0, nil
+	}
+
+	equal := 0
+	for i := range a {
+		if a[i] == b[i] {
+			equal++
+		}
+	}
+	return float64(equal) / float64(len(a)), nil
+}
+
+// JaccardIndex is a banded LSH index over MinHash signatures for finding similar sets
+// Signatures are split into bands of rows values and sets sharing all values of any band are candidates.
+// Two sets with Jaccard similarity s become candidates with probability 1 - (1 - s^rows)^bands,
+// an S-curve whose steepest point is near the threshold (1/bands)^(1/rows).
+// Add must not be called concurrently with other methods.
+type JaccardIndex struct {
+	hasher     *MinHasher
+	threshold  float64
+	bands      int
+	rows       int
+	tables     []map[uint64][]int
+	signatures map[int]MinHashSignature
+}
+
+// Initialize a new empty index for sets whose Jaccard similarity is at least threshold
+// The number of bands and rows per band is chosen from the permutations so that the
+// S-curve threshold is as close as possible to the target threshold.
+func NewJaccardIndex(permutations int, threshold float64, seed int64) (*JaccardIndex, error) {
+	if !(threshold > 0 && threshold <= 1) {
+		return nil, vector.ErrInvalidParameter
+	}
+	hasher, err := NewMinHasher(permutations, seed)
+	if err != nil {
+		return nil, err
+	}
+
+	bands, rows := Bands(permutations, threshold)
+	tables := make([]map[uint64][]int, bands)
+	for i := range tables {
+		tables[i] = make(map[uint64][]int)
+	}
+	return &JaccardIndex{
+		hasher:     hasher,
+		threshold:  threshold,
+		bands:      bands,
+		rows:       rows,
+		tables:     tables,
+		signatures: make(map[int]MinHashSignature),
+	}, nil
+}
+
+// Chooses the number of bands and rows per band (with bands*rows <= permutations)
+// whose S-curve threshold (1/bands)^(1/rows) is closest to the target threshold
+func Bands(permutations int, threshold float64) (int, int) {
+	bestBands, bestRows, bestErr := permutations, 1, math.Inf(1)
+	for rows := 1; rows <= permutations; rows++ {
+		bands := permutations / rows
+		t := math.Pow(1/float64(bands), 1/float64(rows))
+		if e := math.Abs(t - threshold); e < bestErr {
+			bestBands, bestRows, bestErr = bands, rows, e
+		}
+	}
+	return bestBands, bestRows
+}
+
+// Returns the MinHasher used to compute the signatures of the index
+func (j *JaccardIndex) Hasher() *MinHasher {
+	return j.hasher
+}
+
+// Returns the number of bands and rows per band of the index
+func (j *JaccardIndex) Bands() (int, int) {
+	return j.bands, j.rows
+}
+
+// Add a set with the given ID to the index
+func (j *JaccardIndex) Add(id int, s *set.Set) error {
+	return j.AddSignature(id, j.hasher.Signature(s))
+}
+
+// Add a precomputed signature with the given ID to the index
+// ErrDimensionMismatch is returned if the signature was not computed with the permutations of the index
+func (j *JaccardIndex) AddSignature(id int, sig MinHashSignature) error {
+	if len(sig) != j.hasher.Permutations() {
+		return vector.ErrDimensionMismatch
+	}
+
+	j.signatures[id] = sig
+	for b := range j.tables {
+		key := bandKey(sig[b*j.rows : (b+1)*j.rows])
+		j.tables[b][key] = append(j.tables[b][key], id)
+	}
+	return nil
+}
+
+// Returns the sorted IDs of the sets sharing a band with the query set
+// whose estimated Jaccard similarity with it is at least the threshold of the index
+func (j *JaccardIndex) Query(s *set.Set) []int {
+	sig := j.hasher.Signature(s)
+	seen := map[int]bool{}
+	result := []int{}
+	for b := range j.tables {
+		for _, id := range j.tables[b][bandKey(sig[b*j.rows:(b+1)*j.rows])] {
+			if seen[id] {
+				continue
+			}
+			seen[id] = true
+			if EstimateJaccard(sig, j.signatures[id]) >= j.threshold {
+				result = append(result, id)
+			}
+		}
+	}
+	sort.Ints(result)
+	return result
+}
+
+// Returns every pair of IDs in the index sharing a band whose estimated Jaccard similarity
+// is at least the threshold of the index, as [2]int{smaller ID, larger ID} sorted pairs
+func (j *JaccardIndex) Pairs() [][2]int {
+	seen := map[[2]int]bool{}
+	result := [][2]int{}
+	for b := range j.tables {
+		for _, bucket := range j.tables[b] {
+			for x := 0; x < len(bucket); x++ {
+				for y := x + 1; y < len(bucket); y++ {
+					pair := [2]int{bucket[x], bucket[y]}
+					if pair[0] > pair[1] {
+						pair[0], pair[1] = pair[1], pair[0]
+					}
+					if seen[pair] || pair[0] == pair[1] {
+						continue
+					}
+					seen[pair] = true
+					if EstimateJaccard(j.signatures[pair[0]], j.signatures[pair[1]]) >= j.threshold {
+						result = append(result, pair)
+					}
+				}
+			}
+		}
+	}
+	sort.Slice(result, func(x, y int) bool {
+		if result[x][0] != result[y][0] {
+			return result[x][0] < result[y][0]
+		}
+		return result[x][1] < result[y][1]
+	})
+	return result
+}
+
+// Hashes the values of a band to a single bucket key
+func bandKey(values []uint64) uint64 {
+	var h uint64 = 0
+	for _, v := range values {
+		h = mix64(h ^ v)
+	}
+	return h
+}
+
+// Computes (a * b) mod (2^61 - 1) without overflow, for a, b < 2^61
+func mulmod61(a uint64, b uint64) uint64 {
+	hi, lo := bits.Mul64(a, b)
+	// a*b = hi*2^64 + lo, and 2^61 = 1 (mod 2^61 - 1)
+	r := (lo & mersenne61) + (lo >> 61) + (hi << 3)
+	r = (r & mersenne61) + (r >> 61)
+	if r >= mersenne61 {
+		r -= mersenne61
+	}
+	return r
+}
+
+// Scrambles the bits of x (the splitmix64 finaliser)
+func mix64(x uint64) uint64 {
+	x += 0x9e3779b97f4a7c15
+	x = (x ^ (x >> 30)) * 0xbf58476d1ce4e5b9
+	x = (x ^ (x >> 27)) * 0x94d049bb133111eb
+	return x ^ (x >> 31)
+}
@@ -0,0 +1,107 @@
+package lsh
+
+import (
+	"math"
+	"math/rand"
+	"reflect"
+	"testing"
+
+	"github.com/rexsimiloluwah/distance_metrics/set"
+	"github.com/rexsimiloluwah/distance_metrics/vector"
+)
+
+func rangeSet(from int, to int) *set.Set {
+	var elements []float64
+	for i := from; i < to; i++ {
+		elements = append(elements, float64(i))
+	}
+	return set.NewSet(elements)
+}
+
+func TestEstimateJaccard(t *testing.T) {
+	m, _ := NewMinHasher(1024, 1)
+	var tests = []struct {
+		s1 *set.Set
+		s2 *set.Set
+	}{
+		{rangeSet(0, 100), rangeSet(0, 100)},
+		{rangeSet(0, 100), rangeSet(50, 150)},
+		{rangeSet(0, 100), rangeSet(90, 200)},
+		{rangeSet(0, 10), rangeSet(100, 110)},
+		{set.NewSet([]float64{}), set.NewSet([]float64{})},
+	}
+
+	for _, test := range tests {
+		expected := set.Jaccard(test.s1, test.s2)
+		if output := EstimateJaccard(m.Signature(test.s1), m.Signature(test.s2)); math.Abs(output-expected) > 0.05 {
+			t.Error("Test Failed,", expected, " expected,", output, " received.")
+		}
+	}
+}
+
+func TestSignatureReproducible(t *testing.T) {
+	a, _ := NewMinHasher(64, 7)
+	b, _ := NewMinHasher(64, 7)
+	s := rangeSet(0, 30)
+	if !reflect.DeepEqual(a.Signature(s), b.Signature(s)) {
+		t.Error("Test Failed, equal signatures expected for equal seeds")
+	}
+	if !reflect.DeepEqual(a.SignatureOf([]float64{0, 1, 1, 2}), a.SignatureOf([]float64{2, 1, math.Copysign(0, -1)})) {
+		t.Error("Test Failed, signatures expected to depend only on the distinct elements")
+	}
+}
+
+func TestBands(t *testing.T) {
+	var tests = []struct {
+		permutations int
+		threshold    float64
+	}{
+		{128, 0.5},
+		{128, 0.8},
+		{256, 0.3},
+	}
+
+	for _, test := range tests {
+		bands, rows := Bands(test.permutations, test.threshold)
+		if bands*rows > test.permutations {
+			t.Error("Test Failed, at most", test.permutations, "values expected,", bands*rows, " received.")
+		}
+		if output := math.Pow(1/float64(bands), 1/float64(rows)); math.Abs(output-test.threshold) > 0.1 {
+			t.Error("Test Failed,", test.threshold, " expected,", output, " received.")
+		}
+	}
+}
+
+func TestJaccardIndex(t *testing.T) {
+	rng := rand.New(rand.NewSource(2))
+	index, err := NewJaccardIndex(128, 0.6, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Sets 0 and 1 overlap heavily (Jaccard 0.82), the rest are random sets over a large universe
+	index.Add(0, rangeSet(0, 100))
+	index.Add(1, rangeSet(10, 110))
+	for id := 2; id < 100; id++ {
+		var elements []float64
+		for i := 0; i < 100; i++ {
+			elements = append(elements, float64(rng.Intn(100000)))
+		}
+		index.Add(id, set.NewSet(elements))
+	}
+
+	if output := index.Pairs(); !reflect.DeepEqual([][2]int{{0, 1}}, output) {
+		t.Error("Test Failed,", [][2]int{{0, 1}}, " expected,", output, " received.")
+	}
+	if output := index.Query(rangeSet(5, 105)); !reflect.DeepEqual([]int{0, 1}, output) {
+		t.Error("Test Failed,", []int{0, 1}, " expected,", output, " received.")
+	}
+
+	other, _ := NewMinHasher(64, 3)
+	if err := index.AddSignature(100, other.Signature(rangeSet(0, 10))); err != vector.ErrDimensionMismatch {
+		t.Error("Test Failed,", vector.ErrDimensionMismatch, " expected,", err, " received.")
+	}
+	if _, err := TryEstimateJaccard(other.Signature(rangeSet(0, 10)), index.Hasher().Signature(rangeSet(0, 10))); err != vector.ErrDimensionMismatch {
+		t.Error("Test Failed,", vector.ErrDimensionMismatch, " expected,", err, " received.")
+	}
+}
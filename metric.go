@@ -0,0 +1,98 @@
+package distance_metrics
+
+import (
+	"math"
+
+	"github.com/rexsimiloluwah/distance_metrics/vector"
+)
+
+// Kind tells whether smaller or larger values of a metric mean more alike inputs
+type Kind int
+
+const (
+	// Distance metrics are 0 (or minimal) for identical inputs and grow as the inputs differ
+	Distance Kind = iota
+	// Similarity metrics are maximal for identical inputs and shrink as the inputs differ
+	Similarity
+)
+
+func (k Kind) String() string {
+	if k == Similarity {
+		return "similarity"
+	}
+	return "distance"
+}
+
+// Range is the closed interval of values a metric can take, with infinite bounds for unbounded metrics
+type Range struct {
+	Min float64
+	Max float64
+}
+
+// Reports whether a value lies within the range
+func (r Range) Contains(value float64) bool {
+	return value >= r.Min && value <= r.Max
+}
+
+// Commonly used ranges
+var (
+	Unbounded   = Range{Min: math.Inf(-1), Max: math.Inf(1)}
+	NonNegative = Range{Min: 0, Max: math.Inf(1)}
+	UnitRange   = Range{Min: 0, Max: 1}
+	SignedUnit  = Range{Min: -1, Max: 1}
+)
+
+// Info describes the properties of a metric
+type Info struct {
+	// Name is the name of the metric in its registry
+	Name string
+	// Kind tells whether the metric is a distance or a similarity
+	Kind Kind
+	// IsMetric reports whether the metric is a true metric: non-negative, zero only between
+	// identical inputs, symmetric and satisfying the triangle inequality
+	IsMetric bool
+	// Symmetric reports whether Compute(a, b) always equals Compute(b, a)
+	Symmetric bool
+	// Range is the set of values the metric can take
+	Range Range
+}
+
+// Metric is a distance or similarity between two values of type T, along with its properties
+// It lets generic code accept any metric of this module regardless of the shape of the underlying function.
+type Metric[T any] interface {
+	// Info returns the properties of the metric
+	Info() Info
+	// Compute returns the distance or similarity between a and b, or an error on invalid input
+	Compute(a T, b T) (float64, error)
+}
+
+type funcMetric[T any] struct {
+	info Info
+	f    func(a T, b T) (float64, error)
+}
+
+func (m funcMetric[T]) Info() Info { return m.info }
+
+func (m funcMetric[T]) Compute(a T, b T) (float64, error) { return m.f(a, b) }
+
+// Initialize a new metric from its properties and a function computing it
+func NewMetric[T any](info Info, f func(a T, b T) (float64, error)) Metric[T] {
+	return funcMetric[T]{info: info, f: f}
+}
+
+// Initialize a new metric from its properties and a function that cannot fail
+func NewInfallibleMetric[T any](info Info, f func(a T, b T) float64) Metric[T] {
+	return funcMetric[T]{info: info, f: func(a T, b T) (float64, error) { return f(a, b), nil }}
+}
+
+// Converts a vector metric into a vector.DistanceFunc for use with vector.Pairwise or the neighbors package
+// The returned function panics with the error of the metric on invalid input.
+func DistanceFunc(m Metric[vector.Vector]) vector.DistanceFunc {
+	return func(a vector.Vector, b vector.Vector) float64 {
+		result, err := m.Compute(a, b)
+		if err != nil {
+			panic(err)
+		}
+		return result
+	}
+}
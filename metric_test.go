@@ -0,0 +1,50 @@
+package distance_metrics
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/rexsimiloluwah/distance_metrics/vector"
+)
+
+const floatDifferenceThresh = 1e-4
+
+func TestNewMetric(t *testing.T) {
+	m := NewInfallibleMetric(trueMetric("absolute", NonNegative), func(a float64, b float64) float64 {
+		return math.Abs(a - b)
+	})
+	if output, err := m.Compute(2, 5); err != nil || output != 3 {
+		t.Error("Test Failed,", 3, " expected,", output, " received.")
+	}
+	if info := m.Info(); info.Name != "absolute" || info.Kind != Distance || !info.IsMetric || !info.Symmetric {
+		t.Error("Test Failed, unexpected info", info, " received.")
+	}
+	if Similarity.String() != "similarity" || Distance.String() != "distance" {
+		t.Error("Test Failed, unexpected kind names", Distance, Similarity, " received.")
+	}
+	if !UnitRange.Contains(1) || UnitRange.Contains(-0.1) || !Unbounded.Contains(math.Inf(1)) {
+		t.Error("Test Failed, unexpected range membership")
+	}
+}
+
+func TestDistanceFunc(t *testing.T) {
+	m, err := Vectors.Lookup("manhattan", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rows := []vector.Vector{{0, 0}, {1, 2}, {3, 3}}
+	c, err := vector.Pairwise(context.Background(), rows, DistanceFunc(m), 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if output := c.At(0, 2); output != 6 {
+		t.Error("Test Failed,", 6, " expected,", output, " received.")
+	}
+
+	// Errors of the metric surface as panics, which Pairwise returns as errors
+	rows = append(rows, vector.Vector{1})
+	if _, err := vector.Pairwise(context.Background(), rows, DistanceFunc(m), 2); err != vector.ErrDimensionMismatch {
+		t.Error("Test Failed,", vector.ErrDimensionMismatch, " expected,", err, " received.")
+	}
+}
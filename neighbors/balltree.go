@@ -0,0 +1,175 @@
+package neighbors
+
+import (
+	"math"
+)
+
+// Default maximum number of items stored in a ball tree leaf
+const defaultLeafSize = 8
+
+type ballNode struct {
+	pivot  int
+	radius float64
+	left   *ballNode
+	right  *ballNode
+	leaf   []int
+}
+
+// BallTree is a metric tree for exact nearest neighbour and range queries under any metric
+// Each node is a ball around one of its items (the pivot) that covers every item below it,
+// so a whole subtree is skipped when the query is farther than the current bound from its ball.
+// Since only distances between items are used, no centroid or coordinates are needed.
+// Reference: https://en.wikipedia.org/wiki/Ball_tree
+// A BallTree is immutable once built and is safe for concurrent use.
+type BallTree[T any] struct {
+	items    []T
+	distance DistanceFunc[T]
+	leafSize int
+	root     *ballNode
+}
+
+// Initialize a new ball tree over a set of items, using the index of each item as its ID
+// Leaves hold at most leafSize items, 8 if leafSize is not positive; larger leaves trade
+// more distance computations per query for a shallower tree.
+func NewBallTree[T any](items []T, distance DistanceFunc[T], leafSize int) *BallTree[T] {
+	if leafSize <= 0 {
+		leafSize = defaultLeafSize
+	}
+	t := &BallTree[T]{items: items, distance: distance, leafSize: leafSize}
+	ids := make([]int, len(items))
+	for i := range ids {
+		ids[i] = i
+	}
+	t.root = t.build(ids)
+	return t
+}
+
+func (t *BallTree[T]) build(ids []int) *ballNode {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	node := &ballNode{pivot: ids[0]}
+	for _, id := range ids[1:] {
+		node.radius = math.Max(node.radius, t.distance(t.items[node.pivot], t.items[id]))
+	}
+	if len(ids) <= t.leafSize || node.radius == 0 {
+		node.leaf = ids
+		return node
+	}
+
+	// Split around two far apart items: p1 farthest from the pivot, and p2 farthest from p1
+	p1 := t.farthest(node.pivot, ids)
+	p2 := t.farthest(p1, ids)
+	var left, right []int
+	for _, id := range ids {
+		if t.distance(t.items[id], t.items[p1]) <= t.distance(t.items[id], t.items[p2]) {
+			left = append(left, id)
+		} else {
+			right = append(right, id)
+		}
+	}
+	if len(left) == 0 || len(right) == 0 {
+		node.leaf = ids
+		return node
+	}
+
+	// Use each half's split item as its pivot so that the balls stay tight
+	node.left = t.build(moveFirst(left, p1))
+	node.right = t.build(moveFirst(right, p2))
+	return node
+}
+
+// Returns the item among ids farthest from the given item
+func (t *BallTree[T]) farthest(from int, ids []int) int {
+	best, bestDist := from, -1.0
+	for _, id := range ids {
+		if d := t.distance(t.items[from], t.items[id]); d > bestDist {
+			best, bestDist = id, d
+		}
+	}
+	return best
+}
+
+// Moves id to the front of ids if present
+func moveFirst(ids []int, id int) []int {
+	for i := range ids {
+		if ids[i] == id {
+			ids[0], ids[i] = ids[i], ids[0]
+			break
+		}
+	}
+	return ids
+}
+
+// Returns the number of items in the tree
+func (t *BallTree[T]) Len() int {
+	return len(t.items)
+}
+
+// Returns the k items closest to the query, sorted by increasing distance
+func (t *BallTree[T]) KNearest(query T, k int) []Neighbor {
+	if k <= 0 || t.root == nil {
+		return []Neighbor{}
+	}
+	c := newCandidates(k)
+	t.knn(t.root, query, t.distance(query, t.items[t.root.pivot]), c)
+	return c.sorted()
+}
+
+// Searches a node given the distance from the query to its pivot
+func (t *BallTree[T]) knn(node *ballNode, query T, d float64, c *candidates) {
+	if c.full() && d-node.radius > c.worst() {
+		return
+	}
+
+	if node.leaf != nil {
+		c.offer(Neighbor{ID: node.pivot, Distance: d})
+		for _, id := range node.leaf[1:] {
+			c.offer(Neighbor{ID: id, Distance: t.distance(query, t.items[id])})
+		}
+		return
+	}
+
+	// Descend into the closer child first to tighten the bound early
+	dl := t.distance(query, t.items[node.left.pivot])
+	dr := t.distance(query, t.items[node.right.pivot])
+	if dl <= dr {
+		t.knn(node.left, query, dl, c)
+		t.knn(node.right, query, dr, c)
+	} else {
+		t.knn(node.right, query, dr, c)
+		t.knn(node.left, query, dl, c)
+	}
+}
+
+// Returns every item within distance r of the query (inclusive), sorted by increasing distance
+func (t *BallTree[T]) Radius(query T, r float64) []Neighbor {
+	result := []Neighbor{}
+	if t.root != nil {
+		t.radius(t.root, query, t.distance(query, t.items[t.root.pivot]), r, &result)
+	}
+	sortNeighbors(result)
+	return result
+}
+
+func (t *BallTree[T]) radius(node *ballNode, query T, d float64, r float64, result *[]Neighbor) {
+	if d-node.radius > r {
+		return
+	}
+
+	if node.leaf != nil {
+		if d <= r {
+			*result = append(*result, Neighbor{ID: node.pivot, Distance: d})
+		}
+		for _, id := range node.leaf[1:] {
+			if di := t.distance(query, t.items[id]); di <= r {
+				*result = append(*result, Neighbor{ID: id, Distance: di})
+			}
+		}
+		return
+	}
+
+	t.radius(node.left, query, t.distance(query, t.items[node.left.pivot]), r, result)
+	t.radius(node.right, query, t.distance(query, t.items[node.right.pivot]), r, result)
+}
@@ -0,0 +1,45 @@
+package neighbors
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+
+	"github.com/rexsimiloluwah/distance_metrics/vector"
+)
+
+func TestBallTreeStrings(t *testing.T) {
+	tree := NewBallTree(words, levensthein, 0)
+	for _, query := range []string{"kitten", "sitten", "knit", "xyz"} {
+		expectedK, expectedR := bruteForce(words, levensthein, query, 4, 3)
+		if output := tree.KNearest(query, 4); !reflect.DeepEqual(expectedK, output) {
+			t.Error("Test Failed,", expectedK, " expected,", output, " received.")
+		}
+		if output := tree.Radius(query, 3); !reflect.DeepEqual(expectedR, output) {
+			t.Error("Test Failed,", expectedR, " expected,", output, " received.")
+		}
+	}
+}
+
+func TestBallTreeVectors(t *testing.T) {
+	rng := rand.New(rand.NewSource(11))
+	points := randomPoints(rng, 500, 3)
+	for _, leafSize := range []int{0, 1, 32} {
+		tree := NewBallTree(points, vector.Manhattan, leafSize)
+		for q := 0; q < 10; q++ {
+			query := randomPoints(rng, 1, 3)[0]
+			expectedK, expectedR := bruteForce(points, vector.Manhattan, query, 7, 6)
+			if output := tree.KNearest(query, 7); !reflect.DeepEqual(expectedK, output) {
+				t.Error("Test Failed,", expectedK, " expected,", output, " received.")
+			}
+			if output := tree.Radius(query, 6); !reflect.DeepEqual(expectedR, output) {
+				t.Error("Test Failed,", expectedR, " expected,", output, " received.")
+			}
+		}
+	}
+
+	empty := NewBallTree([]vector.Vector{}, vector.Euclidean, 0)
+	if output := empty.KNearest(vector.Vector{1}, 3); len(output) != 0 {
+		t.Error("Test Failed, no neighbors expected,", output, " received.")
+	}
+}
@@ -0,0 +1,166 @@
+package neighbors
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/rexsimiloluwah/distance_metrics/vector"
+)
+
+// BruteForce is an exact nearest neighbour index that compares the query against every stored vector
+// Any distance function can be used, as long as smaller values mean closer vectors
+// (e.g. use vector.CosineDissimilarity rather than vector.CosineSimilarity).
+// A BruteForce is safe for concurrent use.
+type BruteForce struct {
+	// Workers is the number of goroutines used to scan the stored vectors, 1 or less scans sequentially
+	Workers int
+
+	metric vector.DistanceFunc
+	mu     sync.RWMutex
+	ids    []int
+	points []vector.Vector
+}
+
+// Initialize a new empty brute force index using the given distance function
+func NewBruteForce(metric vector.DistanceFunc) *BruteForce {
+	return &BruteForce{metric: metric, Workers: 1}
+}
+
+// Add a vector with the given ID to the index
+// All vectors must be non-empty and of the same length
+func (b *BruteForce) Add(id int, v vector.Vector) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if v.Length() == 0 {
+		return vector.ErrEmptyVector
+	}
+	if len(b.points) > 0 && b.points[0].Length() != v.Length() {
+		return vector.ErrDimensionMismatch
+	}
+	b.ids = append(b.ids, id)
+	b.points = append(b.points, v)
+	return nil
+}
+
+// Returns the number of vectors in the index
+func (b *BruteForce) Len() int {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	return len(b.points)
+}
+
+// Returns the k stored vectors closest to the query, sorted by increasing distance
+// Fewer than k neighbors are returned if the index holds fewer than k vectors
+func (b *BruteForce) KNearest(query vector.Vector, k int) ([]Neighbor, error) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	if err := b.check(query); err != nil {
+		return nil, err
+	}
+	if k <= 0 {
+		return []Neighbor{}, nil
+	}
+
+	parts, err := b.scan(func(lo int, hi int) []Neighbor {
+		c := newCandidates(k)
+		for i := lo; i < hi; i++ {
+			c.offer(Neighbor{ID: b.ids[i], Distance: b.metric(query, b.points[i])})
+		}
+		return c.items
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	c := newCandidates(k)
+	for _, part := range parts {
+		for _, n := range part {
+			c.offer(n)
+		}
+	}
+	return c.sorted(), nil
+}
+
+// Returns every stored vector within distance r of the query (inclusive), sorted by increasing distance
+func (b *BruteForce) Radius(query vector.Vector, r float64) ([]Neighbor, error) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	if err := b.check(query); err != nil {
+		return nil, err
+	}
+
+	parts, err := b.scan(func(lo int, hi int) []Neighbor {
+		var found []Neighbor
+		for i := lo; i < hi; i++ {
+			if d := b.metric(query, b.points[i]); d <= r {
+				found = append(found, Neighbor{ID: b.ids[i], Distance: d})
+			}
+		}
+		return found
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := []Neighbor{}
+	for _, part := range parts {
+		result = append(result, part...)
+	}
+	sortNeighbors(result)
+	return result, nil
+}
+
+// Validates a query vector against the stored vectors
+func (b *BruteForce) check(query vector.Vector) error {
+	if query.Length() == 0 {
+		return vector.ErrEmptyVector
+	}
+	if len(b.points) > 0 && b.points[0].Length() != query.Length() {
+		return vector.ErrDimensionMismatch
+	}
+	return nil
+}
+
+// Splits the stored vectors into one contiguous chunk per worker and runs f on each chunk concurrently
+// A panic in f, e.g. from a metric rejecting a stored vector, is recovered and returned as an error
+func (b *BruteForce) scan(f func(lo int, hi int) []Neighbor) ([][]Neighbor, error) {
+	n := len(b.points)
+	workers := b.Workers
+	if workers > n {
+		workers = n
+	}
+	if workers < 1 {
+		workers = 1
+	}
+
+	parts := make([][]Neighbor, workers)
+	errs := make([]error, workers)
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			defer func() {
+				if r := recover(); r != nil {
+					if err, ok := r.(error); ok {
+						errs[w] = err
+					} else {
+						errs[w] = fmt.Errorf("neighbors: metric panicked: %v", r)
+					}
+				}
+			}()
+			parts[w] = f(w*n/workers, (w+1)*n/workers)
+		}(w)
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return nil, err
+		}
+	}
+	return parts, nil
+}
@@ -0,0 +1,119 @@
+package neighbors
+
+import (
+	"errors"
+	"math/rand"
+	"reflect"
+	"testing"
+
+	"github.com/rexsimiloluwah/distance_metrics/vector"
+)
+
+func newTestIndex(workers int) *BruteForce {
+	b := NewBruteForce(vector.Euclidean)
+	b.Workers = workers
+	points := []vector.Vector{{0, 0}, {1, 0}, {0, 2}, {3, 3}, {-1, -1}, {5, 0}}
+	for i, p := range points {
+		b.Add(i+10, p)
+	}
+	return b
+}
+
+func TestBruteForceKNearest(t *testing.T) {
+	var tests = []struct {
+		query    vector.Vector
+		k        int
+		expected []int
+	}{
+		{vector.Vector{0, 0}, 3, []int{10, 11, 14}},
+		{vector.Vector{4, 1}, 2, []int{15, 13}},
+		{vector.Vector{0, 0}, 10, []int{10, 11, 14, 12, 13, 15}},
+		{vector.Vector{0, 0}, 0, []int{}},
+	}
+
+	for _, workers := range []int{1, 4} {
+		b := newTestIndex(workers)
+		for _, test := range tests {
+			result, err := b.KNearest(test.query, test.k)
+			ids := []int{}
+			for _, n := range result {
+				ids = append(ids, n.ID)
+			}
+			if err != nil || !reflect.DeepEqual(ids, test.expected) {
+				t.Error("Test Failed,", test.expected, " expected,", ids, err, " received.")
+			}
+		}
+	}
+}
+
+func TestBruteForceRadius(t *testing.T) {
+	var tests = []struct {
+		query    vector.Vector
+		r        float64
+		expected []int
+	}{
+		{vector.Vector{0, 0}, 1.5, []int{10, 11, 14}},
+		{vector.Vector{0, 0}, 2, []int{10, 11, 14, 12}},
+		{vector.Vector{10, 10}, 1, []int{}},
+	}
+
+	for _, workers := range []int{1, 3} {
+		b := newTestIndex(workers)
+		for _, test := range tests {
+			result, err := b.Radius(test.query, test.r)
+			ids := []int{}
+			for _, n := range result {
+				ids = append(ids, n.ID)
+			}
+			if err != nil || !reflect.DeepEqual(ids, test.expected) {
+				t.Error("Test Failed,", test.expected, " expected,", ids, err, " received.")
+			}
+		}
+	}
+}
+
+func TestBruteForceParallelMatchesSequential(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	seq, par := NewBruteForce(vector.Manhattan), NewBruteForce(vector.Manhattan)
+	par.Workers = 8
+	for i := 0; i < 500; i++ {
+		v := vector.Vector{rng.Float64(), rng.Float64(), rng.Float64()}
+		seq.Add(i, v)
+		par.Add(i, v)
+	}
+
+	query := vector.Vector{0.5, 0.5, 0.5}
+	expected, _ := seq.KNearest(query, 7)
+	if output, _ := par.KNearest(query, 7); !reflect.DeepEqual(expected, output) {
+		t.Error("Test Failed,", expected, " expected,", output, " received.")
+	}
+}
+
+func TestBruteForceErrors(t *testing.T) {
+	b := newTestIndex(1)
+	if err := b.Add(99, vector.Vector{1, 2, 3}); !errors.Is(err, vector.ErrDimensionMismatch) {
+		t.Error("Test Failed,", vector.ErrDimensionMismatch, " expected,", err, " received.")
+	}
+	if _, err := b.KNearest(vector.Vector{}, 1); !errors.Is(err, vector.ErrEmptyVector) {
+		t.Error("Test Failed,", vector.ErrEmptyVector, " expected,", err, " received.")
+	}
+	if b.Len() != 6 {
+		t.Error("Test Failed,", 6, " expected,", b.Len(), " received.")
+	}
+}
+
+func TestBruteForceMetricPanic(t *testing.T) {
+	for _, workers := range []int{1, 4} {
+		b := NewBruteForce(vector.CosineDissimilarity)
+		b.Workers = workers
+		for i, p := range []vector.Vector{{1, 0}, {0, 1}, {0, 0}, {1, 1}} {
+			b.Add(i, p)
+		}
+		if _, err := b.KNearest(vector.Vector{1, 0}, 2); !errors.Is(err, vector.ErrZeroMagnitude) {
+			t.Error("Test Failed,", vector.ErrZeroMagnitude, " expected,", err, " received.")
+		}
+		if _, err := b.Radius(vector.Vector{1, 0}, 1); !errors.Is(err, vector.ErrZeroMagnitude) {
+			t.Error("Test Failed,", vector.ErrZeroMagnitude, " expected,", err, " received.")
+		}
+	}
+}
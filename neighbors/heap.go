@@ -0,0 +1,109 @@
+package neighbors
+
+import (
+	"container/heap"
+	"sort"
+)
+
+// Neighbor is a search result, the ID of a stored vector and its distance to the query
+type Neighbor struct {
+	ID       int
+	Distance float64
+}
+
+// candidates is a max-heap of at most k neighbors, used to track the k nearest seen so far
+// The root is the farthest of the current candidates, so it can be replaced in O(log k)
+type candidates struct {
+	k     int
+	items []Neighbor
+}
+
+// Initial capacity of a candidate heap, which then grows with the neighbors offered
+// k is not used directly as it comes from the caller and may far exceed the number of items searched.
+const initialCandidates = 64
+
+func newCandidates(k int) *candidates {
+	return &candidates{k: k, items: make([]Neighbor, 0, minInt(k, initialCandidates))}
+}
+
+func (c *candidates) Len() int { return len(c.items) }
+func (c *candidates) Less(i, j int) bool {
+	if c.items[i].Distance != c.items[j].Distance {
+		return c.items[i].Distance > c.items[j].Distance
+	}
+	return c.items[i].ID > c.items[j].ID
+}
+func (c *candidates) Swap(i, j int)      { c.items[i], c.items[j] = c.items[j], c.items[i] }
+func (c *candidates) Push(x interface{}) { c.items = append(c.items, x.(Neighbor)) }
+func (c *candidates) Pop() interface{} {
+	n := len(c.items)
+	item := c.items[n-1]
+	c.items = c.items[:n-1]
+	return item
+}
+
+// Offers a neighbor to the heap, keeping it only if it is among the k nearest
+func (c *candidates) offer(n Neighbor) {
+	if len(c.items) < c.k {
+		heap.Push(c, n)
+		return
+	}
+	top := c.items[0]
+	if n.Distance < top.Distance || (n.Distance == top.Distance && n.ID < top.ID) {
+		c.items[0] = n
+		heap.Fix(c, 0)
+	}
+}
+
+// Returns true if the heap holds k candidates
+func (c *candidates) full() bool {
+	return len(c.items) >= c.k
+}
+
+// Returns the distance of the farthest candidate, the bound a new neighbor must beat once the heap is full
+func (c *candidates) worst() float64 {
+	return c.items[0].Distance
+}
+
+// Returns the candidates sorted by increasing distance
+func (c *candidates) sorted() []Neighbor {
+	result := append([]Neighbor(nil), c.items...)
+	sortNeighbors(result)
+	return result
+}
+
+// Sorts neighbors by increasing distance, breaking ties by ID
+func sortNeighbors(ns []Neighbor) {
+	sort.Slice(ns, func(i, j int) bool {
+		if ns[i].Distance != ns[j].Distance {
+			return ns[i].Distance < ns[j].Distance
+		}
+		return ns[i].ID < ns[j].ID
+	})
+}
+
+// TopK collects the k nearest of a stream of neighbors using a bounded max-heap
+// It is useful for custom scans, e.g. over quantized or sparse vectors
+type TopK struct {
+	c *candidates
+}
+
+// Initialize a new collector of the k nearest neighbors
+func NewTopK(k int) *TopK {
+	if k < 0 {
+		k = 0
+	}
+	return &TopK{c: newCandidates(k)}
+}
+
+// Offers a neighbor, keeping it only if it is among the k nearest seen so far
+func (t *TopK) Push(n Neighbor) {
+	if t.c.k > 0 {
+		t.c.offer(n)
+	}
+}
+
+// Returns the k nearest neighbors seen so far, sorted by increasing distance
+func (t *TopK) Results() []Neighbor {
+	return t.c.sorted()
+}
@@ -0,0 +1,49 @@
+package neighbors
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/rexsimiloluwah/distance_metrics/vector"
+)
+
+func TestTopK(t *testing.T) {
+	top := NewTopK(3)
+	for i, d := range []float64{5, 1, 4, 1, 3, 9} {
+		top.Push(Neighbor{ID: i, Distance: d})
+	}
+	expected := []Neighbor{{1, 1}, {3, 1}, {4, 3}}
+	if output := top.Results(); !reflect.DeepEqual(expected, output) {
+		t.Error("Test Failed,", expected, " expected,", output, " received.")
+	}
+	if output := NewTopK(0); len(output.Results()) != 0 {
+		t.Error("Test Failed, no neighbors expected,", output.Results(), " received.")
+	}
+}
+
+func TestKNearestLargeK(t *testing.T) {
+	points := []vector.Vector{{0, 0}, {1, 0}, {0, 2}, {3, 3}}
+	b := NewBruteForce(vector.Euclidean)
+	for i, p := range points {
+		b.Add(i, p)
+	}
+	kd, _ := NewKDTree(points, EuclideanMetric)
+	vp := NewVPTree(points, vector.Euclidean, 1)
+	ball := NewBallTree(points, vector.Euclidean, 0)
+
+	const k = 1 << 62
+	fromBruteForce, _ := b.KNearest(vector.Vector{0, 0}, k)
+	fromKDTree, _ := kd.KNearest(vector.Vector{0, 0}, k)
+	top := NewTopK(k)
+	for i, p := range points {
+		top.Push(Neighbor{ID: i, Distance: vector.Euclidean(vector.Vector{0, 0}, p)})
+	}
+
+	expected := []int{0, 1, 2, 3}
+	for _, output := range [][]Neighbor{fromBruteForce, fromKDTree, vp.KNearest(vector.Vector{0, 0}, k),
+		ball.KNearest(vector.Vector{0, 0}, k), top.Results()} {
+		if !reflect.DeepEqual(expected, ids(output)) {
+			t.Error("Test Failed,", expected, " expected,", ids(output), " received.")
+		}
+	}
+}
@@ -0,0 +1,395 @@
+package neighbors
+
+import (
+	"container/heap"
+	"math"
+	"math/rand"
+	"sort"
+	"sync"
+
+	"github.com/rexsimiloluwah/distance_metrics/vector"
+)
+
+// Space selects the similarity space used by an HNSW index
+type Space int
+
+const (
+	// CosineSpace ranks by cosine similarity, reporting 1 - CosineSimilarity as the distance
+	CosineSpace Space = iota
+	// InnerProductSpace ranks by dot product, reporting -Dot as the distance
+	InnerProductSpace
+	// EuclideanSpace ranks by Euclidean distance
+	EuclideanSpace
+)
+
+// HNSWConfig holds the tuning parameters of an HNSW index
+type HNSWConfig struct {
+	// Space is the similarity space of the index
+	Space Space
+	// M is the number of neighbours each vector is linked to per layer (2*M on the bottom layer)
+	M int
+	// EfConstruction is the size of the candidate list used while inserting, higher builds a better graph
+	EfConstruction int
+	// EfSearch is the size of the candidate list used while querying, higher improves recall
+	EfSearch int
+	// Seed makes the random layer assignment, and so the graph, reproducible
+	Seed int64
+}
+
+// Returns a configuration with commonly used default parameters
+func DefaultHNSWConfig(space Space) HNSWConfig {
+	return HNSWConfig{Space: space, M: 16, EfConstruction: 200, EfSearch: 50, Seed: 1}
+}
+
+type hnswNode struct {
+	id      int
+	point   vector.Vector
+	links   [][]int
+	deleted bool
+}
+
+// HNSW is a Hierarchical Navigable Small World graph for approximate nearest neighbour search
+// Vectors are linked to their nearest neighbours on a hierarchy of layers, each an exponentially
+// sparser subset of the one below, and a query greedily walks from the top layer down.
+// Deleted vectors are kept in the graph as tombstones so that it stays connected, but are never returned.
+// Reference: Malkov and Yashunin, "Efficient and robust approximate nearest neighbor search using
+// Hierarchical Navigable Small World graphs", https://arxiv.org/abs/1603.09320
+// An HNSW is safe for concurrent use.
+type HNSW struct {
+	config HNSWConfig
+	ml     float64
+
+	mu       sync.RWMutex
+	rng      *rand.Rand
+	dim      int
+	nodes    []*hnswNode
+	ids      map[int]int
+	entry    int
+	maxLevel int
+	live     int
+}
+
+// Initialize a new empty HNSW index
+// ErrInvalidParameter is returned if M < 2 or either ef parameter is not positive
+func NewHNSW(config HNSWConfig) (*HNSW, error) {
+	if config.M < 2 || config.EfConstruction <= 0 || config.EfSearch <= 0 {
+		return nil, vector.ErrInvalidParameter
+	}
+	return &HNSW{
+		config: config,
+		ml:     1 / math.Log(float64(config.M)),
+		rng:    rand.New(rand.NewSource(config.Seed)),
+		ids:    make(map[int]int),
+		entry:  -1,
+	}, nil
+}
+
+// Returns the number of live (not deleted) vectors in the index
+func (h *HNSW) Len() int {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	return h.live
+}
+
+// Sets the size of the candidate list used by subsequent queries
+func (h *HNSW) SetEfSearch(ef int) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if ef > 0 {
+		h.config.EfSearch = ef
+	}
+}
+
+// Computes the distance between two vectors in the space of the index
+// Cosine vectors are normalised on insertion, so the cosine distance reduces to 1 - dot product
+func (h *HNSW) distance(a vector.Vector, b vector.Vector) float64 {
+	switch h.config.Space {
+	case CosineSpace:
+		return 1 - a.Dot(b)
+	case InnerProductSpace:
+		return -a.Dot(b)
+	}
+	var result float64 = 0
+	for i := range a {
+		d := a[i] - b[i]
+		result += d * d
+	}
+	return math.Sqrt(result)
+}
+
+// Validates a vector and prepares it for the space of the index
+func (h *HNSW) prepare(v vector.Vector) (vector.Vector, error) {
+	if v.Length() == 0 {
+		return nil, vector.ErrEmptyVector
+	}
+	if h.dim != 0 && v.Length() != h.dim {
+		return nil, vector.ErrDimensionMismatch
+	}
+	if h.config.Space != CosineSpace {
+		return v, nil
+	}
+
+	m := v.Magnitude()
+	if m == 0 {
+		return nil, vector.ErrZeroMagnitude
+	}
+	result := make(vector.Vector, v.Length())
+	for i, value := range v {
+		result[i] = value / m
+	}
+	return result, nil
+}
+
+// Insert a vector with the given ID into the index
+func (h *HNSW) Insert(id int, v vector.Vector) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if _, ok := h.ids[id]; ok {
+		return ErrDuplicateID
+	}
+	point, err := h.prepare(v)
+	if err != nil {
+		return err
+	}
+	h.dim = point.Length()
+
+	level := int(-math.Log(1-h.rng.Float64()) * h.ml)
+	node := &hnswNode{id: id, point: point, links: make([][]int, level+1)}
+	idx := len(h.nodes)
+	h.nodes = append(h.nodes, node)
+	h.ids[id] = idx
+	h.live++
+
+	if h.entry < 0 {
+		h.entry, h.maxLevel = idx, level
+		return nil
+	}
+
+	ep := h.greedy(point, h.entry, h.maxLevel, level)
+	for l := minInt(level, h.maxLevel); l >= 0; l-- {
+		found := h.searchLayer(point, []int{ep}, h.config.EfConstruction, l, false)
+		neighbors := h.selectNeighbors(found, h.maxLinks(l))
+		node.links[l] = neighbors
+		for _, n := range neighbors {
+			h.link(n, idx, l)
+		}
+		ep = found[0].index
+	}
+
+	if level > h.maxLevel {
+		h.entry, h.maxLevel = idx, level
+	}
+	return nil
+}
+
+// Marks the vector with the given ID as deleted, reporting whether it was present
+// The vector stays in the graph as a tombstone to keep it connected, but is never returned by queries
+func (h *HNSW) Delete(id int) bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	idx, ok := h.ids[id]
+	if !ok {
+		return false
+	}
+	h.nodes[idx].deleted = true
+	delete(h.ids, id)
+	h.live--
+	return true
+}
+
+// Returns approximately the k stored vectors closest to the query, sorted by increasing distance
+func (h *HNSW) KNearest(query vector.Vector, k int) ([]Neighbor, error) {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	point, err := h.prepare(query)
+	if err != nil {
+		return nil, err
+	}
+	if k <= 0 || h.entry < 0 {
+		return []Neighbor{}, nil
+	}
+
+	k = minInt(k, h.live)
+	if k == 0 {
+		return []Neighbor{}, nil
+	}
+
+	ep := h.greedy(point, h.entry, h.maxLevel, 0)
+	found := h.searchLayer(point, []int{ep}, maxInt(h.config.EfSearch, k), 0, true)
+
+	result := make([]Neighbor, 0, k)
+	for _, c := range found[:minInt(k, len(found))] {
+		result = append(result, Neighbor{ID: h.nodes[c.index].id, Distance: c.distance})
+	}
+	return result, nil
+}
+
+// Returns the maximum number of links of a node on a layer
+func (h *HNSW) maxLinks(layer int) int {
+	if layer == 0 {
+		return 2 * h.config.M
+	}
+	return h.config.M
+}
+
+// Walks greedily from the entry point towards the query on every layer above the target layer
+func (h *HNSW) greedy(point vector.Vector, ep int, from int, to int) int {
+	d := h.distance(point, h.nodes[ep].point)
+	for l := from; l > to; l-- {
+		for changed := true; changed; {
+			changed = false
+			for _, n := range h.nodes[ep].links[l] {
+				if dn := h.distance(point, h.nodes[n].point); dn < d {
+					ep, d, changed = n, dn, true
+				}
+			}
+		}
+	}
+	return ep
+}
+
+// Adds a link from node a to node b on a layer, pruning a's links if it has too many
+func (h *HNSW) link(a int, b int, layer int) {
+	node := h.nodes[a]
+	node.links[layer] = append(node.links[layer], b)
+	if len(node.links[layer]) <= h.maxLinks(layer) {
+		return
+	}
+
+	candidates := make([]hnswCandidate, len(node.links[layer]))
+	for i, n := range node.links[layer] {
+		candidates[i] = hnswCandidate{index: n, distance: h.distance(node.point, h.nodes[n].point)}
+	}
+	sort.Slice(candidates, func(i, j int) bool { return candidates[i].less(candidates[j]) })
+	node.links[layer] = h.selectNeighbors(candidates, h.maxLinks(layer))
+}
+
+// Selects up to m neighbours from candidates sorted by increasing distance using the diversity heuristic
+// A candidate is preferred if it is closer to the base than to every neighbour selected so far,
+// which keeps links spread in different directions; the remaining slots are filled by the closest rejects.
+func (h *HNSW) selectNeighbors(candidates []hnswCandidate, m int) []int {
+	selected := make([]int, 0, m)
+	var rejected []int
+	for _, c := range candidates {
+		if len(selected) >= m {
+			break
+		}
+		good := true
+		for _, s := range selected {
+			if h.distance(h.nodes[c.index].point, h.nodes[s].point) < c.distance {
+				good = false
+				break
+			}
+		}
+		if good {
+			selected = append(selected, c.index)
+		} else {
+			rejected = append(rejected, c.index)
+		}
+	}
+	for _, r := range rejected {
+		if len(selected) >= m {
+			break
+		}
+		selected = append(selected, r)
+	}
+	return selected
+}
+
+// Searches a layer from the entry points, returning up to ef closest nodes sorted by increasing distance
+// With skipDeleted set, tombstones are still walked through but never returned, and the search goes on
+// until ef live nodes are found or every reachable node has been visited.
+func (h *HNSW) searchLayer(point vector.Vector, eps []int, ef int, layer int, skipDeleted bool) []hnswCandidate {
+	visited := make(map[int]bool, ef*4)
+	frontier := &hnswHeap{}
+	found := &hnswHeap{max: true}
+	keep := func(c hnswCandidate) {
+		if skipDeleted && h.nodes[c.index].deleted {
+			return
+		}
+		heap.Push(found, c)
+		if found.Len() > ef {
+			heap.Pop(found)
+		}
+	}
+	for _, ep := range eps {
+		c := hnswCandidate{index: ep, distance: h.distance(point, h.nodes[ep].point)}
+		visited[ep] = true
+		heap.Push(frontier, c)
+		keep(c)
+	}
+
+	for frontier.Len() > 0 {
+		c := heap.Pop(frontier).(hnswCandidate)
+		if found.Len() == ef && c.distance > found.items[0].distance {
+			break
+		}
+		for _, n := range h.nodes[c.index].links[layer] {
+			if visited[n] {
+				continue
+			}
+			visited[n] = true
+			nc := hnswCandidate{index: n, distance: h.distance(point, h.nodes[n].point)}
+			if found.Len() < ef || nc.less(found.items[0]) {
+				heap.Push(frontier, nc)
+				keep(nc)
+			}
+		}
+	}
+
+	result := append([]hnswCandidate(nil), found.items...)
+	sort.Slice(result, func(i, j int) bool { return result[i].less(result[j]) })
+	return result
+}
+
+type hnswCandidate struct {
+	index    int
+	distance float64
+}
+
+// Orders candidates by distance, breaking ties by insertion order so results are deterministic
+func (c hnswCandidate) less(o hnswCandidate) bool {
+	if c.distance != o.distance {
+		return c.distance < o.distance
+	}
+	return c.index < o.index
+}
+
+// hnswHeap is a min-heap of candidates, or a max-heap if max is set
+type hnswHeap struct {
+	max   bool
+	items []hnswCandidate
+}
+
+func (q *hnswHeap) Len() int { return len(q.items) }
+func (q *hnswHeap) Less(i, j int) bool {
+	if q.max {
+		return q.items[j].less(q.items[i])
+	}
+	return q.items[i].less(q.items[j])
+}
+func (q *hnswHeap) Swap(i, j int)      { q.items[i], q.items[j] = q.items[j], q.items[i] }
+func (q *hnswHeap) Push(x interface{}) { q.items = append(q.items, x.(hnswCandidate)) }
+func (q *hnswHeap) Pop() interface{} {
+	n := len(q.items)
+	item := q.items[n-1]
+	q.items = q.items[:n-1]
+	return item
+}
+
+func minInt(a int, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func maxInt(a int, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
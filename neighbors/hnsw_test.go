@@ -0,0 +1,201 @@
+package neighbors
+
+import (
+	"errors"
+	"math/rand"
+	"reflect"
+	"sync"
+	"testing"
+
+	"github.com/rexsimiloluwah/distance_metrics/vector"
+)
+
+func gaussianPoints(rng *rand.Rand, n int, dim int) []vector.Vector {
+	points := make([]vector.Vector, n)
+	for i := range points {
+		points[i] = make(vector.Vector, dim)
+		for j := range points[i] {
+			points[i][j] = rng.NormFloat64()
+		}
+	}
+	return points
+}
+
+// Returns the fraction of expected IDs present in the output
+func recall(expected []Neighbor, output []Neighbor) float64 {
+	found := map[int]bool{}
+	for _, n := range output {
+		found[n.ID] = true
+	}
+	hits := 0
+	for _, n := range expected {
+		if found[n.ID] {
+			hits++
+		}
+	}
+	return float64(hits) / float64(len(expected))
+}
+
+func TestHNSWRecall(t *testing.T) {
+	var tests = []struct {
+		space  Space
+		metric vector.DistanceFunc
+	}{
+		{EuclideanSpace, vector.Euclidean},
+		{CosineSpace, vector.CosineDissimilarity},
+		{InnerProductSpace, func(a vector.Vector, b vector.Vector) float64 { return -a.Dot(b) }},
+	}
+
+	rng := rand.New(rand.NewSource(42))
+	points := gaussianPoints(rng, 1000, 8)
+	queries := gaussianPoints(rng, 50, 8)
+
+	for _, test := range tests {
+		config := DefaultHNSWConfig(test.space)
+		config.M, config.EfConstruction = 8, 100
+		index, err := NewHNSW(config)
+		if err != nil {
+			t.Fatal(err)
+		}
+		bf := NewBruteForce(test.metric)
+		for i, p := range points {
+			index.Insert(i, p)
+			bf.Add(i, p)
+		}
+
+		var total float64
+		for _, q := range queries {
+			expected, _ := bf.KNearest(q, 10)
+			output, _ := index.KNearest(q, 10)
+			total += recall(expected, output)
+		}
+		if r := total / float64(len(queries)); r < 0.9 {
+			t.Error("Test Failed, recall of at least 0.9 expected for space", test.space, ",", r, " received.")
+		}
+	}
+}
+
+func TestHNSWDeterministic(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	points := gaussianPoints(rng, 300, 4)
+	build := func() *HNSW {
+		index, _ := NewHNSW(DefaultHNSWConfig(EuclideanSpace))
+		for i, p := range points {
+			index.Insert(i, p)
+		}
+		return index
+	}
+
+	a, b := build(), build()
+	query := vector.Vector{0.1, -0.2, 0.3, 0}
+	expected, _ := a.KNearest(query, 5)
+	if output, _ := b.KNearest(query, 5); !reflect.DeepEqual(expected, output) {
+		t.Error("Test Failed,", expected, " expected,", output, " received.")
+	}
+}
+
+func TestHNSWDelete(t *testing.T) {
+	index, _ := NewHNSW(DefaultHNSWConfig(EuclideanSpace))
+	for i := 0; i < 50; i++ {
+		index.Insert(i, vector.Vector{float64(i), 0})
+	}
+	if !index.Delete(10) || index.Delete(10) {
+		t.Error("Test Failed, deletion of 10 expected to succeed exactly once")
+	}
+
+	output, _ := index.KNearest(vector.Vector{10, 0}, 2)
+	if ids := ids(output); !reflect.DeepEqual([]int{9, 11}, ids) {
+		t.Error("Test Failed,", []int{9, 11}, " expected,", ids, " received.")
+	}
+	if index.Len() != 49 {
+		t.Error("Test Failed,", 49, " expected,", index.Len(), " received.")
+	}
+}
+
+func TestHNSWDeleteNearestRegion(t *testing.T) {
+	config := DefaultHNSWConfig(EuclideanSpace)
+	config.EfSearch = 4
+	index, _ := NewHNSW(config)
+	for i := 0; i < 100; i++ {
+		index.Insert(i, vector.Vector{float64(i), 0})
+	}
+	for i := 0; i < 20; i++ {
+		index.Delete(i)
+	}
+
+	var tests = []struct {
+		k        int
+		expected []int
+	}{
+		{3, []int{20, 21, 22}},
+		{10, []int{20, 21, 22, 23, 24, 25, 26, 27, 28, 29}},
+		{1000, nil},
+	}
+	for _, test := range tests {
+		output, _ := index.KNearest(vector.Vector{0, 0}, test.k)
+		if len(output) != minInt(test.k, index.Len()) {
+			t.Error("Test Failed,", minInt(test.k, index.Len()), " expected,", len(output), " received.")
+		}
+		if test.expected != nil && !reflect.DeepEqual(test.expected, ids(output)) {
+			t.Error("Test Failed,", test.expected, " expected,", ids(output), " received.")
+		}
+	}
+
+	for i := 20; i < 100; i++ {
+		index.Delete(i)
+	}
+	if output, _ := index.KNearest(vector.Vector{0, 0}, 3); len(output) != 0 {
+		t.Error("Test Failed,", 0, " expected,", len(output), " received.")
+	}
+}
+
+func TestHNSWConcurrent(t *testing.T) {
+	rng := rand.New(rand.NewSource(9))
+	points := gaussianPoints(rng, 400, 6)
+	index, _ := NewHNSW(DefaultHNSWConfig(CosineSpace))
+
+	var wg sync.WaitGroup
+	for w := 0; w < 4; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			for i := w; i < len(points); i += 4 {
+				index.Insert(i, points[i])
+				index.KNearest(points[i], 3)
+			}
+		}(w)
+	}
+	wg.Wait()
+
+	if index.Len() != len(points) {
+		t.Error("Test Failed,", len(points), " expected,", index.Len(), " received.")
+	}
+	if output, _ := index.KNearest(points[7], 1); len(output) != 1 || output[0].ID != 7 {
+		t.Error("Test Failed,", 7, " expected,", output, " received.")
+	}
+}
+
+func TestHNSWErrors(t *testing.T) {
+	if _, err := NewHNSW(HNSWConfig{M: 1, EfConstruction: 10, EfSearch: 10}); !errors.Is(err, vector.ErrInvalidParameter) {
+		t.Error("Test Failed,", vector.ErrInvalidParameter, " expected,", err, " received.")
+	}
+
+	index, _ := NewHNSW(DefaultHNSWConfig(CosineSpace))
+	index.Insert(1, vector.Vector{1, 0})
+	var tests = []struct {
+		id       int
+		v        vector.Vector
+		expected error
+	}{
+		{1, vector.Vector{0, 1}, ErrDuplicateID},
+		{2, vector.Vector{0, 0}, vector.ErrZeroMagnitude},
+		{3, vector.Vector{1, 2, 3}, vector.ErrDimensionMismatch},
+		{4, vector.Vector{}, vector.ErrEmptyVector},
+	}
+
+	for _, test := range tests {
+		if err := index.Insert(test.id, test.v); !errors.Is(err, test.expected) {
+			t.Error("Test Failed,", test.expected, " expected,", err, " received.")
+		}
+	}
+}
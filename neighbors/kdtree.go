@@ -0,0 +1,330 @@
+package neighbors
+
+import (
+	"errors"
+	"math"
+	"sort"
+	"sync"
+
+	"github.com/rexsimiloluwah/distance_metrics/vector"
+)
+
+// ErrDuplicateID is returned when inserting a vector under an ID that is already in the index
+var ErrDuplicateID = errors.New("neighbors: duplicate id")
+
+// KDMetric selects the coordinate metric used by a KDTree
+type KDMetric int
+
+const (
+	// EuclideanMetric is the Euclidean (L2) distance
+	EuclideanMetric KDMetric = iota
+	// ManhattanMetric is the Manhattan (L1) distance
+	ManhattanMetric
+	// ChebyshevMetric is the Chebyshev (L-infinity) distance
+	ChebyshevMetric
+)
+
+// Computes the distance between two vectors under the metric
+func (m KDMetric) distance(a vector.Vector, b vector.Vector) float64 {
+	var result float64 = 0
+	for i := range a {
+		d := math.Abs(a[i] - b[i])
+		switch m {
+		case EuclideanMetric:
+			result += d * d
+		case ManhattanMetric:
+			result += d
+		case ChebyshevMetric:
+			result = math.Max(result, d)
+		}
+	}
+	if m == EuclideanMetric {
+		return math.Sqrt(result)
+	}
+	return result
+}
+
+type kdNode struct {
+	id      int
+	point   vector.Vector
+	axis    int
+	left    *kdNode
+	right   *kdNode
+	deleted bool
+}
+
+// KDTree is a k-dimensional tree for exact nearest neighbour, radius and box queries on low-dimensional vectors
+// The tree is built by splitting on the median along cycling axes. Inserted vectors are added as leaves
+// and deleted vectors are only marked as removed; the tree is rebuilt from its live vectors once the
+// number of inserts since the last build exceeds the size of that build, or deleted vectors outnumber live ones.
+// Reference: https://en.wikipedia.org/wiki/K-d_tree
+// A KDTree is safe for concurrent use.
+type KDTree struct {
+	metric KDMetric
+	dim    int
+
+	mu       sync.RWMutex
+	root     *kdNode
+	nodes    map[int]*kdNode
+	built    int
+	inserted int
+	deleted  int
+}
+
+// Initialize a new KD-tree from a set of vectors, using the index of each vector as its ID
+// All vectors must be non-empty and of the same length
+func NewKDTree(points []vector.Vector, metric KDMetric) (*KDTree, error) {
+	t := &KDTree{metric: metric, nodes: make(map[int]*kdNode)}
+	nodes := make([]*kdNode, len(points))
+	for i, p := range points {
+		if err := t.check(p); err != nil {
+			return nil, err
+		}
+		t.dim = p.Length()
+		nodes[i] = &kdNode{id: i, point: p}
+		t.nodes[i] = nodes[i]
+	}
+	t.root = build(nodes, 0, t.dim)
+	t.built = len(nodes)
+	return t, nil
+}
+
+// Builds a balanced subtree by recursively splitting the nodes on the median along the current axis
+func build(nodes []*kdNode, depth int, dim int) *kdNode {
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	axis := depth % dim
+	sort.Slice(nodes, func(i, j int) bool { return nodes[i].point[axis] < nodes[j].point[axis] })
+	mid := len(nodes) / 2
+	// Equal coordinates must all lie in the right subtree, so move the median to the first of them
+	for mid > 0 && nodes[mid-1].point[axis] == nodes[mid].point[axis] {
+		mid--
+	}
+
+	node := nodes[mid]
+	node.axis = axis
+	node.left = build(nodes[:mid], depth+1, dim)
+	node.right = build(nodes[mid+1:], depth+1, dim)
+	return node
+}
+
+// Returns the number of live vectors in the tree
+func (t *KDTree) Len() int {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return len(t.nodes)
+}
+
+// Insert a vector with the given ID into the tree
+func (t *KDTree) Insert(id int, v vector.Vector) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if err := t.check(v); err != nil {
+		return err
+	}
+	if _, ok := t.nodes[id]; ok {
+		return ErrDuplicateID
+	}
+	if t.dim == 0 {
+		t.dim = v.Length()
+	}
+
+	node := &kdNode{id: id, point: v}
+	t.nodes[id] = node
+	t.inserted++
+
+	if t.root == nil {
+		t.root = node
+	} else {
+		parent := t.root
+		for {
+			if v[parent.axis] < parent.point[parent.axis] {
+				if parent.left == nil {
+					parent.left = node
+					break
+				}
+				parent = parent.left
+			} else {
+				if parent.right == nil {
+					parent.right = node
+					break
+				}
+				parent = parent.right
+			}
+		}
+		node.axis = (parent.axis + 1) % t.dim
+	}
+
+	if t.inserted > t.built {
+		t.rebuild()
+	}
+	return nil
+}
+
+// Delete the vector with the given ID from the tree, reporting whether it was present
+func (t *KDTree) Delete(id int) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	node, ok := t.nodes[id]
+	if !ok {
+		return false
+	}
+	node.deleted = true
+	delete(t.nodes, id)
+	t.deleted++
+
+	if t.deleted > len(t.nodes) {
+		t.rebuild()
+	}
+	return true
+}
+
+// Rebuild the tree from its live vectors, restoring balance and discarding deleted vectors
+func (t *KDTree) Rebalance() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.rebuild()
+}
+
+func (t *KDTree) rebuild() {
+	nodes := make([]*kdNode, 0, len(t.nodes))
+	for _, node := range t.nodes {
+		node.left, node.right = nil, nil
+		nodes = append(nodes, node)
+	}
+	// Sort by ID first so the rebuilt tree does not depend on map iteration order
+	sort.Slice(nodes, func(i, j int) bool { return nodes[i].id < nodes[j].id })
+	t.root = build(nodes, 0, t.dim)
+	t.built, t.inserted, t.deleted = len(nodes), 0, 0
+}
+
+// Returns the k stored vectors closest to the query, sorted by increasing distance
+func (t *KDTree) KNearest(query vector.Vector, k int) ([]Neighbor, error) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	if err := t.check(query); err != nil {
+		return nil, err
+	}
+	if k <= 0 {
+		return []Neighbor{}, nil
+	}
+
+	c := newCandidates(k)
+	t.knn(t.root, query, c)
+	return c.sorted(), nil
+}
+
+func (t *KDTree) knn(node *kdNode, query vector.Vector, c *candidates) {
+	if node == nil {
+		return
+	}
+	if !node.deleted {
+		c.offer(Neighbor{ID: node.id, Distance: t.metric.distance(query, node.point)})
+	}
+
+	// Visit the side containing the query first; the other side can only hold a closer vector
+	// if the distance to the splitting plane is within the current bound
+	diff := query[node.axis] - node.point[node.axis]
+	near, far := node.left, node.right
+	if diff >= 0 {
+		near, far = far, near
+	}
+	t.knn(near, query, c)
+	if !c.full() || math.Abs(diff) <= c.worst() {
+		t.knn(far, query, c)
+	}
+}
+
+// Returns every stored vector within distance r of the query (inclusive), sorted by increasing distance
+func (t *KDTree) Radius(query vector.Vector, r float64) ([]Neighbor, error) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	if err := t.check(query); err != nil {
+		return nil, err
+	}
+
+	result := []Neighbor{}
+	t.radius(t.root, query, r, &result)
+	sortNeighbors(result)
+	return result, nil
+}
+
+func (t *KDTree) radius(node *kdNode, query vector.Vector, r float64, result *[]Neighbor) {
+	if node == nil {
+		return
+	}
+	if !node.deleted {
+		if d := t.metric.distance(query, node.point); d <= r {
+			*result = append(*result, Neighbor{ID: node.id, Distance: d})
+		}
+	}
+
+	diff := query[node.axis] - node.point[node.axis]
+	if diff-r < 0 {
+		t.radius(node.left, query, r, result)
+	}
+	if diff+r >= 0 {
+		t.radius(node.right, query, r, result)
+	}
+}
+
+// Returns the IDs of every stored vector inside the axis-aligned box [min, max] (inclusive), sorted by ID
+func (t *KDTree) Box(min vector.Vector, max vector.Vector) ([]int, error) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	if err := t.check(min); err != nil {
+		return nil, err
+	}
+	if err := t.check(max); err != nil {
+		return nil, err
+	}
+
+	result := []int{}
+	t.box(t.root, min, max, &result)
+	sort.Ints(result)
+	return result, nil
+}
+
+func (t *KDTree) box(node *kdNode, min vector.Vector, max vector.Vector, result *[]int) {
+	if node == nil {
+		return
+	}
+	if !node.deleted {
+		inside := true
+		for i, value := range node.point {
+			if value < min[i] || value > max[i] {
+				inside = false
+				break
+			}
+		}
+		if inside {
+			*result = append(*result, node.id)
+		}
+	}
+
+	split := node.point[node.axis]
+	if min[node.axis] < split {
+		t.box(node.left, min, max, result)
+	}
+	if max[node.axis] >= split {
+		t.box(node.right, min, max, result)
+	}
+}
+
+// Validates a vector against the dimension of the tree
+func (t *KDTree) check(v vector.Vector) error {
+	if v.Length() == 0 {
+		return vector.ErrEmptyVector
+	}
+	if t.dim != 0 && v.Length() != t.dim {
+		return vector.ErrDimensionMismatch
+	}
+	return nil
+}
@@ -0,0 +1,138 @@
+package neighbors
+
+import (
+	"errors"
+	"math/rand"
+	"reflect"
+	"testing"
+
+	"github.com/rexsimiloluwah/distance_metrics/vector"
+)
+
+func randomPoints(rng *rand.Rand, n int, dim int) []vector.Vector {
+	points := make([]vector.Vector, n)
+	for i := range points {
+		points[i] = make(vector.Vector, dim)
+		for j := range points[i] {
+			// Round to a coarse grid so that ties on the splitting axes are exercised
+			points[i][j] = float64(rng.Intn(20))
+		}
+	}
+	return points
+}
+
+func ids(ns []Neighbor) []int {
+	result := []int{}
+	for _, n := range ns {
+		result = append(result, n.ID)
+	}
+	return result
+}
+
+func TestKDTreeMatchesBruteForce(t *testing.T) {
+	rng := rand.New(rand.NewSource(7))
+	metrics := map[KDMetric]vector.DistanceFunc{
+		EuclideanMetric: vector.Euclidean,
+		ManhattanMetric: vector.Manhattan,
+		ChebyshevMetric: vector.Chebyshev,
+	}
+
+	for m, f := range metrics {
+		points := randomPoints(rng, 300, 3)
+		tree, err := NewKDTree(points, m)
+		if err != nil {
+			t.Fatal(err)
+		}
+		bf := NewBruteForce(f)
+		for i, p := range points {
+			bf.Add(i, p)
+		}
+
+		for q := 0; q < 20; q++ {
+			query := randomPoints(rng, 1, 3)[0]
+			expected, _ := bf.KNearest(query, 5)
+			if output, _ := tree.KNearest(query, 5); !reflect.DeepEqual(expected, output) {
+				t.Error("Test Failed,", expected, " expected,", output, " received.")
+			}
+			expected, _ = bf.Radius(query, 4)
+			if output, _ := tree.Radius(query, 4); !reflect.DeepEqual(expected, output) {
+				t.Error("Test Failed,", expected, " expected,", output, " received.")
+			}
+		}
+	}
+}
+
+func TestKDTreeInsertDelete(t *testing.T) {
+	rng := rand.New(rand.NewSource(3))
+	points := randomPoints(rng, 200, 2)
+	tree, _ := NewKDTree(points[:50], EuclideanMetric)
+	bf := NewBruteForce(vector.Euclidean)
+	for i, p := range points[:50] {
+		bf.Add(i, p)
+	}
+
+	// Inserting past the built size triggers a rebuild along the way
+	for i := 50; i < 200; i++ {
+		if err := tree.Insert(i, points[i]); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// Delete most of the vectors, again forcing rebuilds
+	alive := NewBruteForce(vector.Euclidean)
+	for i, p := range points {
+		if i%4 != 0 {
+			if !tree.Delete(i) {
+				t.Error("Test Failed, deletion of", i, "expected to succeed")
+			}
+		} else {
+			alive.Add(i, p)
+		}
+	}
+	if tree.Delete(1) {
+		t.Error("Test Failed, second deletion of", 1, "expected to fail")
+	}
+	if tree.Len() != 50 {
+		t.Error("Test Failed,", 50, " expected,", tree.Len(), " received.")
+	}
+
+	for q := 0; q < 20; q++ {
+		query := randomPoints(rng, 1, 2)[0]
+		expected, _ := alive.KNearest(query, 4)
+		if output, _ := tree.KNearest(query, 4); !reflect.DeepEqual(expected, output) {
+			t.Error("Test Failed,", expected, " expected,", output, " received.")
+		}
+	}
+
+	if err := tree.Insert(0, points[0]); !errors.Is(err, ErrDuplicateID) {
+		t.Error("Test Failed,", ErrDuplicateID, " expected,", err, " received.")
+	}
+	if err := tree.Insert(1000, vector.Vector{1}); !errors.Is(err, vector.ErrDimensionMismatch) {
+		t.Error("Test Failed,", vector.ErrDimensionMismatch, " expected,", err, " received.")
+	}
+}
+
+func TestKDTreeBox(t *testing.T) {
+	points := []vector.Vector{{0, 0}, {1, 1}, {2, 2}, {1, 3}, {3, 1}, {2, 0}}
+	tree, _ := NewKDTree(points, EuclideanMetric)
+
+	var tests = []struct {
+		min      vector.Vector
+		max      vector.Vector
+		expected []int
+	}{
+		{vector.Vector{0, 0}, vector.Vector{2, 2}, []int{0, 1, 2, 5}},
+		{vector.Vector{1, 1}, vector.Vector{3, 3}, []int{1, 2, 3, 4}},
+		{vector.Vector{5, 5}, vector.Vector{6, 6}, []int{}},
+	}
+
+	for _, test := range tests {
+		if output, err := tree.Box(test.min, test.max); err != nil || !reflect.DeepEqual(test.expected, output) {
+			t.Error("Test Failed,", test.expected, " expected,", output, err, " received.")
+		}
+	}
+
+	tree.Delete(2)
+	if output, _ := tree.Box(vector.Vector{0, 0}, vector.Vector{2, 2}); !reflect.DeepEqual([]int{0, 1, 5}, output) {
+		t.Error("Test Failed,", []int{0, 1, 5}, " expected,", output, " received.")
+	}
+}
@@ -0,0 +1,164 @@
+package neighbors
+
+import (
+	"math/rand"
+)
+
+// DistanceFunc is a distance between two items of any type, e.g. text.Levensthein on strings
+// or set.JaccardDistance on sets. The metric trees require it to satisfy the triangle inequality.
+type DistanceFunc[T any] func(a T, b T) float64
+
+type vpNode struct {
+	id      int
+	mu      float64
+	inside  *vpNode
+	outside *vpNode
+}
+
+// VPTree is a vantage-point tree for exact nearest neighbour and range queries under any metric
+// Each node picks a vantage item and splits the remaining items by whether their distance to it
+// is below the median distance mu, so only the triangle inequality is needed to prune the search.
+// Reference: https://en.wikipedia.org/wiki/Vantage-point_tree
+// A VPTree is immutable once built and is safe for concurrent use.
+type VPTree[T any] struct {
+	items    []T
+	distance DistanceFunc[T]
+	root     *vpNode
+}
+
+// Initialize a new vantage-point tree over a set of items, using the index of each item as its ID
+// The seed makes the choice of vantage points, and so the shape of the tree, reproducible
+func NewVPTree[T any](items []T, distance DistanceFunc[T], seed int64) *VPTree[T] {
+	t := &VPTree[T]{items: items, distance: distance}
+	ids := make([]int, len(items))
+	for i := range ids {
+		ids[i] = i
+	}
+	t.root = t.build(ids, rand.New(rand.NewSource(seed)))
+	return t
+}
+
+func (t *VPTree[T]) build(ids []int, rng *rand.Rand) *vpNode {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	v := rng.Intn(len(ids))
+	ids[0], ids[v] = ids[v], ids[0]
+	node := &vpNode{id: ids[0]}
+	rest := ids[1:]
+	if len(rest) == 0 {
+		return node
+	}
+
+	dists := make(map[int]float64, len(rest))
+	for _, id := range rest {
+		dists[id] = t.distance(t.items[node.id], t.items[id])
+	}
+	mid := len(rest) / 2
+	selectNth(rest, mid, func(a int, b int) bool { return dists[a] < dists[b] })
+	node.mu = dists[rest[mid]]
+
+	// Items strictly closer than mu go inside, the rest (including ties) go outside
+	inside := make([]int, 0, mid)
+	outside := make([]int, 0, len(rest)-mid)
+	for _, id := range rest {
+		if dists[id] < node.mu {
+			inside = append(inside, id)
+		} else {
+			outside = append(outside, id)
+		}
+	}
+	node.inside = t.build(inside, rng)
+	node.outside = t.build(outside, rng)
+	return node
+}
+
+// Returns the number of items in the tree
+func (t *VPTree[T]) Len() int {
+	return len(t.items)
+}
+
+// Returns the k items closest to the query, sorted by increasing distance
+func (t *VPTree[T]) KNearest(query T, k int) []Neighbor {
+	if k <= 0 {
+		return []Neighbor{}
+	}
+	c := newCandidates(k)
+	t.knn(t.root, query, c)
+	return c.sorted()
+}
+
+func (t *VPTree[T]) knn(node *vpNode, query T, c *candidates) {
+	if node == nil {
+		return
+	}
+	d := t.distance(query, t.items[node.id])
+	c.offer(Neighbor{ID: node.id, Distance: d})
+
+	if d < node.mu {
+		t.knn(node.inside, query, c)
+		if !c.full() || d+c.worst() >= node.mu {
+			t.knn(node.outside, query, c)
+		}
+	} else {
+		t.knn(node.outside, query, c)
+		if !c.full() || d-c.worst() < node.mu {
+			t.knn(node.inside, query, c)
+		}
+	}
+}
+
+// Returns every item within distance r of the query (inclusive), sorted by increasing distance
+func (t *VPTree[T]) Radius(query T, r float64) []Neighbor {
+	result := []Neighbor{}
+	t.radius(t.root, query, r, &result)
+	sortNeighbors(result)
+	return result
+}
+
+func (t *VPTree[T]) radius(node *vpNode, query T, r float64, result *[]Neighbor) {
+	if node == nil {
+		return
+	}
+	d := t.distance(query, t.items[node.id])
+	if d <= r {
+		*result = append(*result, Neighbor{ID: node.id, Distance: d})
+	}
+	if d-r < node.mu {
+		t.radius(node.inside, query, r, result)
+	}
+	if d+r >= node.mu {
+		t.radius(node.outside, query, r, result)
+	}
+}
+
+// Partially sorts ids so that ids[n] holds the element that would be there if ids were fully
+// sorted by less, with no larger element before it and no smaller element after it (quickselect)
+func selectNth(ids []int, n int, less func(a int, b int) bool) {
+	lo, hi := 0, len(ids)-1
+	for lo < hi {
+		pivot := ids[(lo+hi)/2]
+		i, j := lo, hi
+		for i <= j {
+			for less(ids[i], pivot) {
+				i++
+			}
+			for less(pivot, ids[j]) {
+				j--
+			}
+			if i <= j {
+				ids[i], ids[j] = ids[j], ids[i]
+				i++
+				j--
+			}
+		}
+		if n <= j {
+			hi = j
+		} else if n >= i {
+			lo = i
+		} else {
+			return
+		}
+	}
+}
@@ -0,0 +1,75 @@
+package neighbors
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+
+	"github.com/rexsimiloluwah/distance_metrics/set"
+	"github.com/rexsimiloluwah/distance_metrics/text"
+	"github.com/rexsimiloluwah/distance_metrics/vector"
+)
+
+func levensthein(a string, b string) float64 {
+	return float64(text.Levensthein(a, b))
+}
+
+var words = []string{"kitten", "sitting", "mitten", "bitten", "fitting", "knitting", "kitchen", "sit", "smitten", "written", "sitter", "kit"}
+
+// Computes the expected neighbors by checking every item
+func bruteForce[T any](items []T, distance DistanceFunc[T], query T, k int, r float64) ([]Neighbor, []Neighbor) {
+	c := newCandidates(k)
+	within := []Neighbor{}
+	for i, item := range items {
+		d := distance(query, item)
+		c.offer(Neighbor{ID: i, Distance: d})
+		if d <= r {
+			within = append(within, Neighbor{ID: i, Distance: d})
+		}
+	}
+	sortNeighbors(within)
+	return c.sorted(), within
+}
+
+func TestVPTreeStrings(t *testing.T) {
+	tree := NewVPTree(words, levensthein, 1)
+	for _, query := range []string{"kitten", "sitten", "knit", "xyz"} {
+		expectedK, expectedR := bruteForce(words, levensthein, query, 3, 2)
+		if output := tree.KNearest(query, 3); !reflect.DeepEqual(expectedK, output) {
+			t.Error("Test Failed,", expectedK, " expected,", output, " received.")
+		}
+		if output := tree.Radius(query, 2); !reflect.DeepEqual(expectedR, output) {
+			t.Error("Test Failed,", expectedR, " expected,", output, " received.")
+		}
+	}
+}
+
+func TestVPTreeSetsAndVectors(t *testing.T) {
+	rng := rand.New(rand.NewSource(5))
+	sets := make([]*set.Set, 100)
+	for i := range sets {
+		var elements []float64
+		for j := 0; j < 10; j++ {
+			elements = append(elements, float64(rng.Intn(20)))
+		}
+		sets[i] = set.NewSet(elements)
+	}
+	setTree := NewVPTree(sets, set.JaccardDistance, 2)
+	expected, _ := bruteForce(sets, set.JaccardDistance, sets[0], 5, 0)
+	if output := setTree.KNearest(sets[0], 5); !reflect.DeepEqual(expected, output) {
+		t.Error("Test Failed,", expected, " expected,", output, " received.")
+	}
+
+	points := randomPoints(rng, 300, 4)
+	vectorTree := NewVPTree(points, vector.Euclidean, 3)
+	for q := 0; q < 10; q++ {
+		query := randomPoints(rng, 1, 4)[0]
+		expectedK, expectedR := bruteForce(points, vector.Euclidean, query, 6, 8)
+		if output := vectorTree.KNearest(query, 6); !reflect.DeepEqual(expectedK, output) {
+			t.Error("Test Failed,", expectedK, " expected,", output, " received.")
+		}
+		if output := vectorTree.Radius(query, 8); !reflect.DeepEqual(expectedR, output) {
+			t.Error("Test Failed,", expectedR, " expected,", output, " received.")
+		}
+	}
+}
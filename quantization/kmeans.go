@@ -0,0 +1,99 @@
+package quantization
+
+import (
+	"math"
+	"math/rand"
+
+	"github.com/rexsimiloluwah/distance_metrics/vector"
+)
+
+// Computes the squared Euclidean distance between two vectors of the same length
+func squaredDistance(a vector.Vector, b vector.Vector) float64 {
+	var result float64 = 0
+	for i := range a {
+		d := a[i] - b[i]
+		result += d * d
+	}
+	return result
+}
+
+// Returns the index of the centroid closest to v and the squared distance to it
+func nearest(v vector.Vector, centroids []vector.Vector) (int, float64) {
+	best, bestDist := 0, math.Inf(1)
+	for i, c := range centroids {
+		if d := squaredDistance(v, c); d < bestDist {
+			best, bestDist = i, d
+		}
+	}
+	return best, bestDist
+}
+
+// Trains k centroids on the data with Lloyd's algorithm and k-means++ seeding
+// The data must hold at least k vectors
+// This is deliberately not cluster.KMeans: the codebooks of a trained quantizer are persisted through
+// the codes it produces, so they must stay identical for a given seed, and every subspace draws from
+// the one rng passed in. cluster.KMeans uses greedy seeding, a convergence tolerance and its own rng,
+// and rejects NaN data that PQ training accepts, so switching would change existing codebooks.
+// Plain k-means++ cannot fail to pick a centroid, so fixes to the greedy seeding do not apply here.
+func kmeans(data []vector.Vector, k int, iterations int, rng *rand.Rand) []vector.Vector {
+	dim := data[0].Length()
+
+	// k-means++: each new centroid is sampled with probability proportional to its squared distance
+	// to the closest centroid chosen so far
+	centroids := []vector.Vector{append(vector.Vector(nil), data[rng.Intn(len(data))]...)}
+	dists := make([]float64, len(data))
+	for len(centroids) < k {
+		var total float64 = 0
+		for i, v := range data {
+			_, dists[i] = nearest(v, centroids)
+			total += dists[i]
+		}
+		next := rng.Intn(len(data))
+		if total > 0 {
+			r := rng.Float64() * total
+			for i, d := range dists {
+				if r -= d; r <= 0 {
+					next = i
+					break
+				}
+			}
+		}
+		centroids = append(centroids, append(vector.Vector(nil), data[next]...))
+	}
+
+	labels := make([]int, len(data))
+	for iter := 0; iter < iterations; iter++ {
+		changed := iter == 0
+		for i, v := range data {
+			if label, _ := nearest(v, centroids); label != labels[i] {
+				labels[i] = label
+				changed = true
+			}
+		}
+		if !changed {
+			break
+		}
+
+		sums := make([]vector.Vector, k)
+		counts := make([]int, k)
+		for i := range sums {
+			sums[i] = make(vector.Vector, dim)
+		}
+		for i, v := range data {
+			counts[labels[i]]++
+			for j, value := range v {
+				sums[labels[i]][j] += value
+			}
+		}
+		for c := range centroids {
+			// Empty clusters keep their previous centroid
+			if counts[c] == 0 {
+				continue
+			}
+			for j := range sums[c] {
+				centroids[c][j] = sums[c][j] / float64(counts[c])
+			}
+		}
+	}
+	return centroids
+}
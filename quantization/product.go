@@ -0,0 +1,213 @@
+package quantization
+
+import (
+	"math"
+	"math/rand"
+
+	"github.com/rexsimiloluwah/distance_metrics/neighbors"
+	"github.com/rexsimiloluwah/distance_metrics/vector"
+)
+
+// Kind selects the distance computed between a query and encoded vectors
+type Kind int
+
+const (
+	// PQEuclidean is the Euclidean distance between the query and the reconstructed vector
+	PQEuclidean Kind = iota
+	// PQInnerProduct is the negated dot product of the query and the reconstructed vector,
+	// so that smaller values still mean closer vectors
+	PQInnerProduct
+)
+
+// PQConfig holds the training parameters of a ProductQuantizer
+type PQConfig struct {
+	// Subspaces is the number of sub-vectors each vector is split into, it must divide the dimension
+	Subspaces int
+	// Centroids is the number of centroids per subspace codebook, at most 256 so that a code fits in a byte
+	Centroids int
+	// Iterations is the maximum number of k-means iterations per codebook, 25 if not set
+	Iterations int
+	// Seed makes the training reproducible
+	Seed int64
+}
+
+// ProductQuantizer compresses vectors into short byte codes
+// Each vector is split into Subspaces equal sub-vectors and each sub-vector is replaced by the index
+// of its nearest centroid in that subspace's codebook, so a d-dimensional float64 vector (8d bytes)
+// is stored in Subspaces bytes. Distances from a full-precision query to encoded vectors are computed
+// asymmetrically from a per-query lookup table of query-to-centroid distances.
+// Reference: Jegou et al., "Product quantization for nearest neighbor search"
+type ProductQuantizer struct {
+	dim       int
+	sub       int
+	codebooks [][]vector.Vector
+}
+
+// Trains the subspace codebooks of a product quantizer on a dataset with k-means
+func TrainProductQuantizer(data []vector.Vector, config PQConfig) (*ProductQuantizer, error) {
+	if len(data) == 0 {
+		return nil, vector.ErrEmptyVector
+	}
+	dim := data[0].Length()
+	for _, v := range data {
+		if v.Length() == 0 {
+			return nil, vector.ErrEmptyVector
+		}
+		if v.Length() != dim {
+			return nil, vector.ErrDimensionMismatch
+		}
+	}
+	if config.Subspaces <= 0 || dim%config.Subspaces != 0 || config.Centroids <= 0 || config.Centroids > 256 {
+		return nil, vector.ErrInvalidParameter
+	}
+	if len(data) < config.Centroids {
+		return nil, vector.ErrInsufficientData
+	}
+
+	if config.Iterations <= 0 {
+		config.Iterations = 25
+	}
+
+	rng := rand.New(rand.NewSource(config.Seed))
+	pq := &ProductQuantizer{dim: dim, sub: dim / config.Subspaces, codebooks: make([][]vector.Vector, config.Subspaces)}
+	for m := range pq.codebooks {
+		subdata := make([]vector.Vector, len(data))
+		for i, v := range data {
+			subdata[i] = pq.subvector(v, m)
+		}
+		pq.codebooks[m] = kmeans(subdata, config.Centroids, config.Iterations, rng)
+	}
+	return pq, nil
+}
+
+// Returns the m-th sub-vector of v
+func (pq *ProductQuantizer) subvector(v vector.Vector, m int) vector.Vector {
+	return v[m*pq.sub : (m+1)*pq.sub]
+}
+
+// Returns the number of bytes in each code
+func (pq *ProductQuantizer) CodeSize() int {
+	return len(pq.codebooks)
+}
+
+// Encodes a vector into its byte code
+func (pq *ProductQuantizer) Encode(v vector.Vector) []byte {
+	if v.Length() != pq.dim {
+		panic(vector.ErrDimensionMismatch)
+	}
+
+	code := make([]byte, len(pq.codebooks))
+	for m, codebook := range pq.codebooks {
+		c, _ := nearest(pq.subvector(v, m), codebook)
+		code[m] = byte(c)
+	}
+	return code
+}
+
+// Decodes a byte code back into the approximate vector it represents
+// It panics with ErrInvalidParameter if a byte of the code is not the index of a centroid
+func (pq *ProductQuantizer) Decode(code []byte) vector.Vector {
+	if len(code) != len(pq.codebooks) {
+		panic(vector.ErrDimensionMismatch)
+	}
+
+	v := make(vector.Vector, 0, pq.dim)
+	for m, c := range code {
+		if int(c) >= len(pq.codebooks[m]) {
+			panic(vector.ErrInvalidParameter)
+		}
+		v = append(v, pq.codebooks[m][c]...)
+	}
+	return v
+}
+
+// DistanceTable holds the precomputed distances between one query and every centroid of every subspace
+type DistanceTable struct {
+	kind  Kind
+	table [][]float64
+}
+
+// Precomputes the lookup table for the asymmetric distances between a query and encoded vectors
+func (pq *ProductQuantizer) DistanceTable(query vector.Vector, kind Kind) *DistanceTable {
+	if query.Length() != pq.dim {
+		panic(vector.ErrDimensionMismatch)
+	}
+
+	t := &DistanceTable{kind: kind, table: make([][]float64, len(pq.codebooks))}
+	for m, codebook := range pq.codebooks {
+		q := pq.subvector(query, m)
+		t.table[m] = make([]float64, len(codebook))
+		for c, centroid := range codebook {
+			if kind == PQInnerProduct {
+				t.table[m][c] = q.Dot(centroid)
+			} else {
+				t.table[m][c] = squaredDistance(q, centroid)
+			}
+		}
+	}
+	return t
+}
+
+// Computes the distance between the query of the table and an encoded vector
+// It costs one table lookup per subspace, independent of the dimension, and panics with
+// ErrInvalidParameter if a byte of the code is not the index of a centroid
+func (t *DistanceTable) Distance(code []byte) float64 {
+	if len(code) != len(t.table) {
+		panic(vector.ErrDimensionMismatch)
+	}
+
+	var result float64 = 0
+	for m, c := range code {
+		if int(c) >= len(t.table[m]) {
+			panic(vector.ErrInvalidParameter)
+		}
+		result += t.table[m][c]
+	}
+	if t.kind == PQInnerProduct {
+		return -result
+	}
+	return math.Sqrt(result)
+}
+
+// Returns the k encoded vectors closest to the query, using their index in codes as ID
+func (pq *ProductQuantizer) Search(query vector.Vector, codes [][]byte, k int, kind Kind) []neighbors.Neighbor {
+	t := pq.DistanceTable(query, kind)
+	top := neighbors.NewTopK(k)
+	for i, code := range codes {
+		top.Push(neighbors.Neighbor{ID: i, Distance: t.Distance(code)})
+	}
+	return top.Results()
+}
+
+// Distortion summarises the reconstruction error of a quantizer over a dataset
+type Distortion struct {
+	// MeanSquaredError is the mean squared Euclidean distance between a vector and its reconstruction
+	MeanSquaredError float64
+	// MaxSquaredError is the largest squared distance between a vector and its reconstruction
+	MaxSquaredError float64
+	// RelativeError is the total squared error divided by the total squared magnitude of the vectors
+	RelativeError float64
+}
+
+// Computes the reconstruction error of encoding and decoding every vector of a dataset
+func (pq *ProductQuantizer) ReconstructionError(data []vector.Vector) Distortion {
+	return reconstructionError(data, func(v vector.Vector) vector.Vector { return pq.Decode(pq.Encode(v)) })
+}
+
+func reconstructionError(data []vector.Vector, roundTrip func(v vector.Vector) vector.Vector) Distortion {
+	var d Distortion
+	var total, norm float64
+	for _, v := range data {
+		e := squaredDistance(v, roundTrip(v))
+		total += e
+		d.MaxSquaredError = math.Max(d.MaxSquaredError, e)
+		norm += v.Dot(v)
+	}
+	if len(data) > 0 {
+		d.MeanSquaredError = total / float64(len(data))
+	}
+	if norm > 0 {
+		d.RelativeError = total / norm
+	}
+	return d
+}
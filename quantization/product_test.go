@@ -0,0 +1,123 @@
+package quantization
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/rexsimiloluwah/distance_metrics/vector"
+)
+
+var floatDifferenceThresh float64 = 1e-4
+
+func gaussianPoints(rng *rand.Rand, n int, dim int) []vector.Vector {
+	points := make([]vector.Vector, n)
+	for i := range points {
+		points[i] = make(vector.Vector, dim)
+		for j := range points[i] {
+			points[i][j] = rng.NormFloat64()
+		}
+	}
+	return points
+}
+
+func TestProductQuantizer(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	data := gaussianPoints(rng, 2000, 8)
+	pq, err := TrainProductQuantizer(data, PQConfig{Subspaces: 4, Centroids: 64, Seed: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pq.CodeSize() != 4 {
+		t.Error("Test Failed,", 4, " expected,", pq.CodeSize(), " received.")
+	}
+
+	// 64 centroids per 2-dimensional subspace should remove most of the variance
+	if d := pq.ReconstructionError(data); d.RelativeError > 0.2 || d.MeanSquaredError <= 0 || d.MaxSquaredError < d.MeanSquaredError {
+		t.Error("Test Failed, a small reconstruction error expected,", d, " received.")
+	}
+
+	// The asymmetric distance is the exact distance from the query to the reconstructed vector
+	query := gaussianPoints(rng, 1, 8)[0]
+	l2 := pq.DistanceTable(query, PQEuclidean)
+	ip := pq.DistanceTable(query, PQInnerProduct)
+	for _, v := range data[:20] {
+		code := pq.Encode(v)
+		decoded := pq.Decode(code)
+		if output, expected := l2.Distance(code), vector.Euclidean(query, decoded); math.Abs(output-expected) > floatDifferenceThresh {
+			t.Error("Test Failed,", expected, " expected,", output, " received.")
+		}
+		if output, expected := ip.Distance(code), -query.Dot(decoded); math.Abs(output-expected) > floatDifferenceThresh {
+			t.Error("Test Failed,", expected, " expected,", output, " received.")
+		}
+	}
+}
+
+func TestProductQuantizerSearch(t *testing.T) {
+	rng := rand.New(rand.NewSource(2))
+	data := gaussianPoints(rng, 1000, 8)
+	pq, _ := TrainProductQuantizer(data, PQConfig{Subspaces: 4, Centroids: 32, Seed: 2})
+	codes := make([][]byte, len(data))
+	for i, v := range data {
+		codes[i] = pq.Encode(v)
+	}
+
+	// Querying with a stored vector should find it among the first few results
+	hits := 0
+	for i := 0; i < 50; i++ {
+		for _, n := range pq.Search(data[i], codes, 5, PQEuclidean) {
+			if n.ID == i {
+				hits++
+			}
+		}
+	}
+	if hits < 45 {
+		t.Error("Test Failed, at least 45 of 50 self matches expected,", hits, " received.")
+	}
+}
+
+func TestProductQuantizerErrors(t *testing.T) {
+	data := []vector.Vector{{1, 2, 3}, {4, 5, 6}, {7, 8, 9}}
+	var tests = []struct {
+		data     []vector.Vector
+		config   PQConfig
+		expected error
+	}{
+		{data, PQConfig{Subspaces: 2, Centroids: 2}, vector.ErrInvalidParameter},
+		{data, PQConfig{Subspaces: 3, Centroids: 257}, vector.ErrInvalidParameter},
+		{data, PQConfig{Subspaces: 3, Centroids: 4}, vector.ErrInsufficientData},
+		{[]vector.Vector{{1, 2}, {1}}, PQConfig{Subspaces: 1, Centroids: 1}, vector.ErrDimensionMismatch},
+		{nil, PQConfig{Subspaces: 1, Centroids: 1}, vector.ErrEmptyVector},
+	}
+
+	for _, test := range tests {
+		if _, err := TrainProductQuantizer(test.data, test.config); !errors.Is(err, test.expected) {
+			t.Error("Test Failed,", test.expected, " expected,", err, " received.")
+		}
+	}
+}
+
+func TestProductQuantizerInvalidCode(t *testing.T) {
+	data := []vector.Vector{{1, 2}, {4, 5}, {7, 8}}
+	pq, err := TrainProductQuantizer(data, PQConfig{Subspaces: 2, Centroids: 3, Seed: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	code := []byte{0, 3}
+	for _, f := range []func(){
+		func() { pq.Decode(code) },
+		func() { pq.DistanceTable(vector.Vector{0, 0}, PQEuclidean).Distance(code) },
+		func() { pq.Search(vector.Vector{0, 0}, [][]byte{code}, 1, PQEuclidean) },
+	} {
+		func() {
+			defer func() {
+				if r := recover(); r != vector.ErrInvalidParameter {
+					t.Error("Test Failed,", vector.ErrInvalidParameter, " expected,", r, " received.")
+				}
+			}()
+			f()
+		}()
+	}
+}
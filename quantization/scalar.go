@@ -0,0 +1,320 @@
+package quantization
+
+import (
+	"math"
+
+	"github.com/rexsimiloluwah/distance_metrics/vector"
+)
+
+/* int8 quantization */
+
+// Int8Vector is a vector quantized to 8 bits per element with a per-vector scale and offset
+// Element i represents the value Offset + Scale*(Codes[i] + 128), where Offset and Offset + 255*Scale
+// are the minimum and maximum of the original vector, so each element is off by at most Scale/2.
+// It uses 1 byte per dimension instead of the 8 bytes of a vector.Vector.
+type Int8Vector struct {
+	Codes  []int8
+	Scale  float64
+	Offset float64
+}
+
+// Quantizes a vector to 8 bits per element using its own minimum and maximum
+func QuantizeInt8(v vector.Vector) Int8Vector {
+	if v.Length() == 0 {
+		return Int8Vector{Codes: []int8{}}
+	}
+
+	lo, hi := v.Min(), v.Max()
+	q := Int8Vector{Codes: make([]int8, v.Length()), Scale: (hi - lo) / 255, Offset: lo}
+	for i, value := range v {
+		q.Codes[i] = encodeInt8(value, lo, q.Scale)
+	}
+	return q
+}
+
+// Maps a value to the nearest of the 256 levels lo + scale*k, k = 0..255, as an int8 code k - 128
+func encodeInt8(value float64, lo float64, scale float64) int8 {
+	if scale == 0 {
+		return -128
+	}
+	k := math.Round((value - lo) / scale)
+	k = math.Max(0, math.Min(255, k))
+	return int8(int(k) - 128)
+}
+
+// Returns the bias such that a code represents the value Scale*code + bias
+func (q Int8Vector) bias() float64 {
+	return q.Offset + 128*q.Scale
+}
+
+// Returns the largest absolute difference between an element of the original vector and its quantized value
+func (q Int8Vector) ErrorBound() float64 {
+	return q.Scale / 2
+}
+
+// Converts the quantized vector back to a float64 vector
+func (q Int8Vector) ToVector() vector.Vector {
+	v := make(vector.Vector, len(q.Codes))
+	c := q.bias()
+	for i, code := range q.Codes {
+		v[i] = q.Scale*float64(code) + c
+	}
+	return v
+}
+
+// Computes the dot product of two quantized vectors from integer sums of their codes
+// The result is the exact dot product of the quantized values. Against the original vectors a and b
+// the error is at most ea*|b|_1 + eb*|a|_1 + n*ea*eb, where ea and eb are the ErrorBounds.
+func (q Int8Vector) Dot(o Int8Vector) float64 {
+	if len(q.Codes) != len(o.Codes) {
+		panic(vector.ErrDimensionMismatch)
+	}
+
+	// With values scale*code + bias, the dot product expands into integer sums of the codes
+	var cross, qsum, osum int64
+	for i := range q.Codes {
+		cross += int64(q.Codes[i]) * int64(o.Codes[i])
+		qsum += int64(q.Codes[i])
+		osum += int64(o.Codes[i])
+	}
+	qc, oc := q.bias(), o.bias()
+	return q.Scale*o.Scale*float64(cross) + q.Scale*oc*float64(qsum) + o.Scale*qc*float64(osum) + float64(len(q.Codes))*qc*oc
+}
+
+// Computes the Euclidean distance between two quantized vectors
+// Against the original vectors the error is at most sqrt(n)*(ea + eb), where ea and eb are the ErrorBounds
+func (q Int8Vector) Euclidean(o Int8Vector) float64 {
+	if len(q.Codes) != len(o.Codes) {
+		panic(vector.ErrDimensionMismatch)
+	}
+
+	var result float64 = 0
+	qc, oc := q.bias(), o.bias()
+	for i := range q.Codes {
+		d := (q.Scale*float64(q.Codes[i]) + qc) - (o.Scale*float64(o.Codes[i]) + oc)
+		result += d * d
+	}
+	return math.Sqrt(result)
+}
+
+// Computes the cosine similarity between two quantized vectors
+func (q Int8Vector) Cosine(o Int8Vector) float64 {
+	return q.Dot(o) / math.Sqrt(q.Dot(q)*o.Dot(o))
+}
+
+// Int8Quantizer quantizes vectors to 8 bits per element with a per-dimension scale and offset
+// trained from the minimum and maximum of each dimension over a dataset. Vectors only store
+// their codes, which suits datasets whose dimensions have very different ranges.
+// Values outside the trained range are clamped to it.
+type Int8Quantizer struct {
+	Scale  vector.Vector
+	Offset vector.Vector
+}
+
+// Trains the per-dimension scale and offset of an int8 quantizer on a dataset
+func TrainInt8Quantizer(data []vector.Vector) (*Int8Quantizer, error) {
+	if len(data) == 0 || data[0].Length() == 0 {
+		return nil, vector.ErrEmptyVector
+	}
+
+	dim := data[0].Length()
+	stats := make([]vector.Stats, dim)
+	for _, v := range data {
+		if v.Length() != dim {
+			return nil, vector.ErrDimensionMismatch
+		}
+		for i, value := range v {
+			stats[i].Push(value)
+		}
+	}
+
+	q := &Int8Quantizer{Scale: make(vector.Vector, dim), Offset: make(vector.Vector, dim)}
+	for i, s := range stats {
+		q.Scale[i] = (s.Max() - s.Min()) / 255
+		q.Offset[i] = s.Min()
+	}
+	return q, nil
+}
+
+// Encodes a vector into int8 codes
+func (q *Int8Quantizer) Encode(v vector.Vector) []int8 {
+	if v.Length() != q.Scale.Length() {
+		panic(vector.ErrDimensionMismatch)
+	}
+
+	codes := make([]int8, v.Length())
+	for i, value := range v {
+		codes[i] = encodeInt8(value, q.Offset[i], q.Scale[i])
+	}
+	return codes
+}
+
+// Decodes int8 codes back into a float64 vector
+func (q *Int8Quantizer) Decode(codes []int8) vector.Vector {
+	if len(codes) != q.Scale.Length() {
+		panic(vector.ErrDimensionMismatch)
+	}
+
+	v := make(vector.Vector, len(codes))
+	for i, code := range codes {
+		v[i] = q.value(i, code)
+	}
+	return v
+}
+
+func (q *Int8Quantizer) value(i int, code int8) float64 {
+	return q.Offset[i] + q.Scale[i]*(float64(code)+128)
+}
+
+// Computes the dot product of two encoded vectors
+func (q *Int8Quantizer) Dot(a []int8, b []int8) float64 {
+	if len(a) != len(b) || len(a) != q.Scale.Length() {
+		panic(vector.ErrDimensionMismatch)
+	}
+
+	var result float64 = 0
+	for i := range a {
+		result += q.value(i, a[i]) * q.value(i, b[i])
+	}
+	return result
+}
+
+// Computes the Euclidean distance between two encoded vectors
+// Since both share the per-dimension offsets, only the code differences matter: sqrt(sum((scale_i*(a_i-b_i))^2))
+func (q *Int8Quantizer) Euclidean(a []int8, b []int8) float64 {
+	if len(a) != len(b) || len(a) != q.Scale.Length() {
+		panic(vector.ErrDimensionMismatch)
+	}
+
+	var result float64 = 0
+	for i := range a {
+		d := q.Scale[i] * float64(int(a[i])-int(b[i]))
+		result += d * d
+	}
+	return math.Sqrt(result)
+}
+
+// Computes the cosine similarity between two encoded vectors
+func (q *Int8Quantizer) Cosine(a []int8, b []int8) float64 {
+	return q.Dot(a, b) / math.Sqrt(q.Dot(a, a)*q.Dot(b, b))
+}
+
+/* float16 quantization */
+
+// Float16Vector is a vector stored as IEEE 754 half-precision floats, 2 bytes per element
+// Values are rounded to the nearest half-precision float, a relative error of at most 2^-11
+// for magnitudes between 6.1e-5 and 65504; larger magnitudes become infinite and smaller ones
+// lose precision gradually down to 6e-8.
+type Float16Vector []uint16
+
+// Quantizes a vector to half precision
+func QuantizeFloat16(v vector.Vector) Float16Vector {
+	f := make(Float16Vector, v.Length())
+	for i, value := range v {
+		f[i] = ToFloat16(value)
+	}
+	return f
+}
+
+// Converts the half-precision vector back to a float64 vector
+func (f Float16Vector) ToVector() vector.Vector {
+	v := make(vector.Vector, len(f))
+	for i, h := range f {
+		v[i] = FromFloat16(h)
+	}
+	return v
+}
+
+// Computes the dot product of two half-precision vectors, accumulated in float64
+func (f Float16Vector) Dot(o Float16Vector) float64 {
+	if len(f) != len(o) {
+		panic(vector.ErrDimensionMismatch)
+	}
+
+	var result float64 = 0
+	for i := range f {
+		result += FromFloat16(f[i]) * FromFloat16(o[i])
+	}
+	return result
+}
+
+// Computes the Euclidean distance between two half-precision vectors, accumulated in float64
+func (f Float16Vector) Euclidean(o Float16Vector) float64 {
+	if len(f) != len(o) {
+		panic(vector.ErrDimensionMismatch)
+	}
+
+	var result float64 = 0
+	for i := range f {
+		d := FromFloat16(f[i]) - FromFloat16(o[i])
+		result += d * d
+	}
+	return math.Sqrt(result)
+}
+
+// Computes the cosine similarity between two half-precision vectors
+func (f Float16Vector) Cosine(o Float16Vector) float64 {
+	return f.Dot(o) / math.Sqrt(f.Dot(f)*o.Dot(o))
+}
+
+// Converts a float64 to the bits of the nearest half-precision float (round half to even)
+func ToFloat16(x float64) uint16 {
+	b := math.Float64bits(x)
+	sign := uint16(b>>48) & 0x8000
+	exp := int(b>>52) & 0x7ff
+	mant := b & (1<<52 - 1)
+
+	if exp == 0x7ff {
+		if mant != 0 {
+			return sign | 0x7e00 // NaN
+		}
+		return sign | 0x7c00 // Inf
+	}
+
+	e := exp - 1023 + 15
+	if e >= 31 {
+		return sign | 0x7c00
+	}
+	if e <= 0 {
+		// Subnormal half: shift the full significand (with its implicit bit) into the 10 mantissa bits
+		shift := uint(42 + 1 - e)
+		if shift >= 54 {
+			return sign
+		}
+		return sign | uint16(roundShift(mant|1<<52, shift))
+	}
+	// A carry out of the mantissa correctly increments the exponent, up to Inf
+	return sign | uint16(uint64(e)<<10+roundShift(mant, 42))
+}
+
+// Converts the bits of a half-precision float to a float64
+func FromFloat16(h uint16) float64 {
+	sign := 1.0
+	if h&0x8000 != 0 {
+		sign = -1
+	}
+	exp := int(h>>10) & 0x1f
+	mant := float64(h & 0x3ff)
+
+	switch exp {
+	case 0:
+		return sign * math.Ldexp(mant, -24)
+	case 31:
+		if mant != 0 {
+			return math.NaN()
+		}
+		return math.Inf(int(sign))
+	}
+	return sign * math.Ldexp(1024+mant, exp-25)
+}
+
+// Shifts m right by s bits, rounding half to even
+func roundShift(m uint64, s uint) uint64 {
+	q := m >> s
+	r := m & (1<<s - 1)
+	half := uint64(1) << (s - 1)
+	if r > half || (r == half && q&1 == 1) {
+		q++
+	}
+	return q
+}
@@ -0,0 +1,150 @@
+package quantization
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/rexsimiloluwah/distance_metrics/vector"
+)
+
+func TestFloat16Conversion(t *testing.T) {
+	var tests = []struct {
+		x        float64
+		expected uint16
+	}{
+		{0, 0x0000},
+		{1, 0x3c00},
+		{-2, 0xc000},
+		{0.1, 0x2e66},
+		{65504, 0x7bff},
+		{65520, 0x7c00}, // rounds up to infinity
+		{70000, 0x7c00},
+		{math.Inf(-1), 0xfc00},
+		{math.Ldexp(1, -24), 0x0001}, // smallest subnormal
+		{math.Ldexp(1, -14), 0x0400}, // smallest normal
+		{1e-8, 0x0000},
+		{1 + math.Ldexp(1, -11), 0x3c00}, // halfway between 1 and the next half rounds to even
+	}
+
+	for _, test := range tests {
+		if output := ToFloat16(test.x); output != test.expected {
+			t.Errorf("Test Failed, %#04x expected, %#04x received for %v.", test.expected, output, test.x)
+		}
+	}
+
+	if !math.IsNaN(FromFloat16(ToFloat16(math.NaN()))) {
+		t.Error("Test Failed, NaN expected to round trip")
+	}
+	for h := 0; h < 0x7c00; h++ {
+		if output := ToFloat16(FromFloat16(uint16(h))); output != uint16(h) {
+			t.Errorf("Test Failed, %#04x expected, %#04x received.", h, output)
+		}
+	}
+}
+
+func TestFloat16Vector(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	a, b := gaussianPoints(rng, 1, 64)[0], gaussianPoints(rng, 1, 64)[0]
+	fa, fb := QuantizeFloat16(a), QuantizeFloat16(b)
+
+	if output, expected := fa.Dot(fb), a.Dot(b); math.Abs(output-expected) > 1e-2 {
+		t.Error("Test Failed,", expected, " expected,", output, " received.")
+	}
+	if output, expected := fa.Euclidean(fb), vector.Euclidean(a, b); math.Abs(output-expected) > 1e-2 {
+		t.Error("Test Failed,", expected, " expected,", output, " received.")
+	}
+	if output, expected := fa.Cosine(fb), vector.CosineSimilarity(a, b); math.Abs(output-expected) > 1e-3 {
+		t.Error("Test Failed,", expected, " expected,", output, " received.")
+	}
+	for i, value := range fa.ToVector() {
+		if math.Abs(value-a[i]) > math.Abs(a[i])*math.Ldexp(1, -11)+1e-7 {
+			t.Error("Test Failed,", a[i], " expected,", value, " received.")
+		}
+	}
+}
+
+func TestInt8Vector(t *testing.T) {
+	rng := rand.New(rand.NewSource(2))
+	for trial := 0; trial < 20; trial++ {
+		a, b := gaussianPoints(rng, 1, 32)[0], gaussianPoints(rng, 1, 32)[0]
+		qa, qb := QuantizeInt8(a), QuantizeInt8(b)
+		ra, rb := qa.ToVector(), qb.ToVector()
+
+		for i := range a {
+			if math.Abs(ra[i]-a[i]) > qa.ErrorBound()+1e-12 {
+				t.Error("Test Failed, error within", qa.ErrorBound(), " expected,", math.Abs(ra[i]-a[i]), " received.")
+			}
+		}
+
+		// Distances on the codes are exact for the reconstructed vectors
+		if output, expected := qa.Dot(qb), ra.Dot(rb); math.Abs(output-expected) > 1e-9 {
+			t.Error("Test Failed,", expected, " expected,", output, " received.")
+		}
+		if output, expected := qa.Euclidean(qb), vector.Euclidean(ra, rb); math.Abs(output-expected) > 1e-9 {
+			t.Error("Test Failed,", expected, " expected,", output, " received.")
+		}
+
+		// and within the documented bounds of the original vectors
+		ea, eb, n := qa.ErrorBound(), qb.ErrorBound(), float64(a.Length())
+		var l1a, l1b float64
+		for i := range a {
+			l1a += math.Abs(a[i])
+			l1b += math.Abs(b[i])
+		}
+		if diff := math.Abs(qa.Dot(qb) - a.Dot(b)); diff > ea*l1b+eb*l1a+n*ea*eb {
+			t.Error("Test Failed, dot product error beyond bound,", diff, " received.")
+		}
+		if diff := math.Abs(qa.Euclidean(qb) - vector.Euclidean(a, b)); diff > math.Sqrt(n)*(ea+eb) {
+			t.Error("Test Failed, Euclidean error beyond bound,", diff, " received.")
+		}
+		if output, expected := qa.Cosine(qb), vector.CosineSimilarity(a, b); math.Abs(output-expected) > 0.02 {
+			t.Error("Test Failed,", expected, " expected,", output, " received.")
+		}
+	}
+
+	constant := QuantizeInt8(vector.Vector{3, 3, 3})
+	if output := constant.ToVector(); output[0] != 3 || output[2] != 3 {
+		t.Error("Test Failed,", vector.Vector{3, 3, 3}, " expected,", output, " received.")
+	}
+}
+
+func TestInt8Quantizer(t *testing.T) {
+	rng := rand.New(rand.NewSource(3))
+	data := gaussianPoints(rng, 200, 16)
+	// Give the dimensions very different ranges
+	for _, v := range data {
+		for i := range v {
+			v[i] *= float64(i + 1)
+		}
+	}
+	q, err := TrainInt8Quantizer(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, v := range data[:20] {
+		decoded := q.Decode(q.Encode(v))
+		for i := range v {
+			if math.Abs(decoded[i]-v[i]) > q.Scale[i]/2+1e-12 {
+				t.Error("Test Failed, error within", q.Scale[i]/2, " expected,", math.Abs(decoded[i]-v[i]), " received.")
+			}
+		}
+	}
+
+	a, b := q.Encode(data[0]), q.Encode(data[1])
+	ra, rb := q.Decode(a), q.Decode(b)
+	if output, expected := q.Dot(a, b), ra.Dot(rb); math.Abs(output-expected) > 1e-9 {
+		t.Error("Test Failed,", expected, " expected,", output, " received.")
+	}
+	if output, expected := q.Euclidean(a, b), vector.Euclidean(ra, rb); math.Abs(output-expected) > 1e-9 {
+		t.Error("Test Failed,", expected, " expected,", output, " received.")
+	}
+	if output, expected := q.Cosine(a, b), vector.CosineSimilarity(ra, rb); math.Abs(output-expected) > 1e-9 {
+		t.Error("Test Failed,", expected, " expected,", output, " received.")
+	}
+
+	if _, err := TrainInt8Quantizer([]vector.Vector{{1, 2}, {3}}); err != vector.ErrDimensionMismatch {
+		t.Error("Test Failed,", vector.ErrDimensionMismatch, " expected,", err, " received.")
+	}
+}
@@ -0,0 +1,208 @@
+package distance_metrics
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/rexsimiloluwah/distance_metrics/vector"
+)
+
+// Sentinel errors returned when looking up metrics by name
+var (
+	ErrUnknownMetric    = errors.New("distance_metrics: unknown metric")
+	ErrDuplicateMetric  = errors.New("distance_metrics: metric already registered")
+	ErrMissingParameter = errors.New("distance_metrics: missing parameter")
+	ErrInvalidParameter = vector.ErrInvalidParameter
+)
+
+const errParameterType = "distance_metrics: parameter %q: expected %s, got %T: %w"
+
+// Params holds the named parameters of a metric, e.g. {"p": 3} for Minkowski
+// Values may be given as Go values or as decoded JSON, so numbers can be any int or float type,
+// and vectors and matrices can be []float64, vector.Vector, []interface{} or their nested forms.
+type Params map[string]interface{}
+
+// Returns a numeric parameter, or def if it is not set
+func (p Params) Float(name string, def float64) (float64, error) {
+	value, ok := p[name]
+	if !ok {
+		return def, nil
+	}
+	if x, ok := toFloat(value); ok {
+		return x, nil
+	}
+	return 0, fmt.Errorf(errParameterType, name, "a number", value, ErrInvalidParameter)
+}
+
+// Returns a boolean parameter, or def if it is not set
+func (p Params) Bool(name string, def bool) (bool, error) {
+	value, ok := p[name]
+	if !ok {
+		return def, nil
+	}
+	if b, ok := value.(bool); ok {
+		return b, nil
+	}
+	return false, fmt.Errorf(errParameterType, name, "a boolean", value, ErrInvalidParameter)
+}
+
+// Returns a vector parameter, which is required unless optional is set (a missing optional vector is nil)
+func (p Params) Vector(name string, optional bool) (vector.Vector, error) {
+	value, ok := p[name]
+	if !ok {
+		if optional {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("distance_metrics: parameter %q: %w", name, ErrMissingParameter)
+	}
+	if v, ok := toVector(value); ok {
+		return v, nil
+	}
+	return nil, fmt.Errorf(errParameterType, name, "a vector", value, ErrInvalidParameter)
+}
+
+// Returns a required matrix parameter
+func (p Params) Matrix(name string) (vector.Matrix, error) {
+	value, ok := p[name]
+	if !ok {
+		return nil, fmt.Errorf("distance_metrics: parameter %q: %w", name, ErrMissingParameter)
+	}
+
+	var rows []interface{}
+	switch m := value.(type) {
+	case vector.Matrix:
+		return m, nil
+	case [][]float64:
+		return vector.Matrix(m), nil
+	case []vector.Vector:
+		for _, row := range m {
+			rows = append(rows, row)
+		}
+	case []interface{}:
+		rows = m
+	default:
+		return nil, fmt.Errorf(errParameterType, name, "a matrix", value, ErrInvalidParameter)
+	}
+
+	result := make(vector.Matrix, len(rows))
+	for i, row := range rows {
+		v, ok := toVector(row)
+		if !ok {
+			return nil, fmt.Errorf(errParameterType, name, "a matrix", value, ErrInvalidParameter)
+		}
+		result[i] = v
+	}
+	return result, nil
+}
+
+func toFloat(value interface{}) (float64, bool) {
+	switch x := value.(type) {
+	case float64:
+		return x, true
+	case float32:
+		return float64(x), true
+	case int:
+		return float64(x), true
+	case int32:
+		return float64(x), true
+	case int64:
+		return float64(x), true
+	case uint:
+		return float64(x), true
+	case uint32:
+		return float64(x), true
+	case uint64:
+		return float64(x), true
+	}
+	return 0, false
+}
+
+func toVector(value interface{}) (vector.Vector, bool) {
+	switch v := value.(type) {
+	case vector.Vector:
+		return v, true
+	case []float64:
+		return vector.Vector(v), true
+	case []interface{}:
+		result := make(vector.Vector, len(v))
+		for i, element := range v {
+			x, ok := toFloat(element)
+			if !ok {
+				return nil, false
+			}
+			result[i] = x
+		}
+		return result, true
+	}
+	return nil, false
+}
+
+// Factory builds a metric from its parameters
+type Factory[T any] func(params Params) (Metric[T], error)
+
+// Registry maps metric names to factories building metrics on values of type T
+// A Registry is safe for concurrent use.
+type Registry[T any] struct {
+	mu        sync.RWMutex
+	factories map[string]Factory[T]
+}
+
+// Initialize a new empty registry
+func NewRegistry[T any]() *Registry[T] {
+	return &Registry[T]{factories: make(map[string]Factory[T])}
+}
+
+// Registers a factory under a name
+// ErrDuplicateMetric is returned if the name is already taken
+func (r *Registry[T]) Register(name string, factory Factory[T]) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.factories[name]; ok {
+		return fmt.Errorf("%w: %q", ErrDuplicateMetric, name)
+	}
+	r.factories[name] = factory
+	return nil
+}
+
+// Builds the metric registered under a name with the given parameters (nil for the defaults)
+// ErrUnknownMetric is returned if no metric has that name
+func (r *Registry[T]) Lookup(name string, params Params) (Metric[T], error) {
+	r.mu.RLock()
+	factory, ok := r.factories[name]
+	r.mu.RUnlock()
+
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", ErrUnknownMetric, name)
+	}
+	if params == nil {
+		params = Params{}
+	}
+	return factory(params)
+}
+
+// Returns the registered names in alphabetical order
+func (r *Registry[T]) Names() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	names := make([]string, 0, len(r.factories))
+	for name := range r.factories {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Registers a metric that takes no parameters
+func (r *Registry[T]) registerFixed(m Metric[T]) {
+	r.mustRegister(m.Info().Name, func(params Params) (Metric[T], error) { return m, nil })
+}
+
+func (r *Registry[T]) mustRegister(name string, factory Factory[T]) {
+	if err := r.Register(name, factory); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,73 @@
+package distance_metrics
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/rexsimiloluwah/distance_metrics/vector"
+)
+
+func TestRegistry(t *testing.T) {
+	r := NewRegistry[string]()
+	m := NewInfallibleMetric(distance("length", NonNegative), func(a string, b string) float64 {
+		return float64(len(a) - len(b))
+	})
+	if err := r.Register("length", func(params Params) (Metric[string], error) { return m, nil }); err != nil {
+		t.Fatal(err)
+	}
+	if err := r.Register("length", func(params Params) (Metric[string], error) { return m, nil }); !errors.Is(err, ErrDuplicateMetric) {
+		t.Error("Test Failed,", ErrDuplicateMetric, " expected,", err, " received.")
+	}
+	if _, err := r.Lookup("width", nil); !errors.Is(err, ErrUnknownMetric) {
+		t.Error("Test Failed,", ErrUnknownMetric, " expected,", err, " received.")
+	}
+	if output := r.Names(); !reflect.DeepEqual(output, []string{"length"}) {
+		t.Error("Test Failed,", []string{"length"}, " expected,", output, " received.")
+	}
+}
+
+func TestParamsFromJSON(t *testing.T) {
+	var config struct {
+		Metric string
+		Params Params
+	}
+	data := `{"metric": "mahalanobis", "params": {"inverse_covariance": [[1, 0], [0, 4]]}}`
+	if err := json.Unmarshal([]byte(data), &config); err != nil {
+		t.Fatal(err)
+	}
+
+	m, err := Vectors.Lookup(config.Metric, config.Params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if output, err := m.Compute(vector.Vector{0, 0}, vector.Vector{3, 2}); err != nil || output != 5 {
+		t.Error("Test Failed,", 5, " expected,", output, " received.")
+	}
+}
+
+func TestParamsErrors(t *testing.T) {
+	var tests = []struct {
+		name     string
+		params   Params
+		expected error
+	}{
+		{"minkowski", Params{"p": "three"}, ErrInvalidParameter},
+		{"minkowski", Params{"p": -1}, ErrInvalidParameter},
+		{"weighted_euclidean", Params{"weights": []interface{}{1, "x"}}, ErrInvalidParameter},
+		{"standardized_euclidean", nil, ErrMissingParameter},
+		{"mahalanobis", Params{"inverse_covariance": 3}, ErrInvalidParameter},
+		{"hellinger", Params{"normalize": 1}, ErrInvalidParameter},
+		{"hellinger", Params{"epsilon": -0.1}, ErrInvalidParameter},
+	}
+
+	for _, test := range tests {
+		if _, err := Vectors.Lookup(test.name, test.params); !errors.Is(err, test.expected) {
+			t.Error("Test Failed,", test.expected, " expected,", err, " received.")
+		}
+	}
+	if _, err := Sets.Lookup("tversky", Params{"alpha": 0, "beta": 0}); !errors.Is(err, ErrInvalidParameter) {
+		t.Error("Test Failed,", ErrInvalidParameter, " expected,", err, " received.")
+	}
+}
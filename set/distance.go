@@ -5,12 +5,16 @@ type set struct {
 	size int
 }
 
+// Set is the exported name of the set type, for use in signatures outside this package
+type Set = set
+
 var exists bool = true
 
 // Compute the Jaccard Similarity Index between two sets
 // Reference: http://en.wikipedia.org/wiki/Jaccard_index
 // Jaccard(A,B) = |A n B| / |A u B|
 // Similarity index ranges between 0 and 1 -> (0,1)
+// Two empty sets have a similarity of 0 but, being identical, a JaccardDistance of 0
 func Jaccard(s1 *set, s2 *set) float64 {
 	if s1.Union(s2).size == 0 {
 		return 0
@@ -20,6 +24,16 @@ func Jaccard(s1 *set, s2 *set) float64 {
 	return float64(num) / float64(den)
 }
 
+// Computes the Jaccard distance between two sets, i.e. 1 - Jaccard(A,B)
+// Unlike the similarity index, the Jaccard distance is a true metric
+// Distance ranges between 0 and 1 -> (0,1), and is 0 for two empty sets as they are identical (as in scipy)
+func JaccardDistance(s1 *set, s2 *set) float64 {
+	if s1.Union(s2).size == 0 {
+		return 0
+	}
+	return 1 - Jaccard(s1, s2)
+}
+
 // Computes the Sorensen-Dice coefficient between two sets
 // Sorensen(A,B) = 2|A n B| / (|A| + |B|)
 // Reference: https://effectivesoftwaredesign.com/2019/02/27/data-science-set-similarity-metrics/
@@ -1,6 +1,7 @@
 package set
 
 import (
+	"math"
 	"reflect"
 	"testing"
 )
@@ -143,3 +144,22 @@ func TestTversky(t *testing.T) {
 		}
 	}
 }
+
+func TestJaccardDistance(t *testing.T) {
+	var tests = []struct {
+		s1       *set
+		s2       *set
+		expected float64
+	}{
+		{NewSet([]float64{1, 2, 3, 4, 5}), NewSet([]float64{1, 2, 3, 4, 5}), 0},
+		{NewSet([]float64{}), NewSet([]float64{}), 0},
+		{NewSet([]float64{0, 1, 2, 5, 6}), NewSet([]float64{0, 2, 3, 4, 5, 7, 9}), 0.6667},
+		{NewSet([]float64{1, 2}), NewSet([]float64{3, 4}), 1},
+	}
+
+	for _, test := range tests {
+		if output := JaccardDistance(test.s1, test.s2); math.Abs(output-test.expected) > floatDifferenceThresh {
+			t.Error("Test Failed,", test.expected, " expected,", output, " received.")
+		}
+	}
+}
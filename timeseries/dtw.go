@@ -0,0 +1,372 @@
+package timeseries
+
+import (
+	"math"
+
+	"github.com/rexsimiloluwah/distance_metrics/vector"
+)
+
+type windowKind int
+
+const (
+	noWindow windowKind = iota
+	sakoeChiba
+	itakura
+)
+
+// Window constrains which elements of two series a warping path may align
+// The zero value allows every alignment.
+type Window struct {
+	kind   windowKind
+	radius int
+	slope  float64
+}
+
+// Returns a Sakoe-Chiba band allowing element i of a to align with element j of b only if |i - j| <= radius
+// For series of lengths n and m the band is widened by |n - m| on the side of the longer series,
+// so that j - i ranges over [-radius, m - n + radius] when m >= n.
+// Reference: Sakoe and Chiba, "Dynamic programming algorithm optimization for spoken word recognition", 1978
+func SakoeChiba(radius int) Window {
+	return Window{kind: sakoeChiba, radius: radius}
+}
+
+// Returns an Itakura parallelogram limiting the local slope of the warping path to between 1/slope and slope
+// Reference: Itakura, "Minimum prediction residual principle applied to speech recognition", 1975
+func Itakura(slope float64) Window {
+	return Window{kind: itakura, slope: slope}
+}
+
+// Validates the parameters of a window
+func (w Window) check() error {
+	switch w.kind {
+	case sakoeChiba:
+		if w.radius < 0 {
+			return vector.ErrInvalidParameter
+		}
+	case itakura:
+		if !(w.slope >= 1) {
+			return vector.ErrInvalidParameter
+		}
+	}
+	return nil
+}
+
+// Returns for every row i the inclusive range [lo[i], hi[i]] of columns the window allows
+// The Itakura parallelogram is widened where needed so that a warping path from (0, 0) to (n-1, m-1) always exists.
+func (w Window) bounds(n int, m int) ([]int, []int) {
+	lo, hi := make([]int, n), make([]int, n)
+	for i := range lo {
+		lo[i], hi[i] = 0, m-1
+		if w.kind == noWindow || n == 1 || m == 1 {
+			continue
+		}
+
+		if w.kind == sakoeChiba {
+			lo[i] = maxInt(0, i-w.radius+minInt(0, m-n))
+			hi[i] = minInt(m-1, i+w.radius+maxInt(0, m-n))
+			continue
+		}
+
+		// Work in normalised coordinates u, v in [0, 1] so that series of different lengths share the diagonal
+		u := float64(i) / float64(n-1)
+		vlo := math.Max(u/w.slope, 1-w.slope*(1-u))
+		vhi := math.Min(w.slope*u, 1-(1-u)/w.slope)
+		const eps = 1e-9
+		lo[i] = maxInt(0, int(math.Ceil(vlo*float64(m-1)-eps)))
+		hi[i] = minInt(m-1, int(math.Floor(vhi*float64(m-1)+eps)))
+	}
+
+	lo[0], hi[n-1] = 0, m-1
+	for i := 1; i < n; i++ {
+		// Each row must overlap or touch the previous one for a step to connect them
+		if lo[i] > hi[i-1]+1 {
+			hi[i-1] = lo[i] - 1
+		}
+		if hi[i] < lo[i-1] {
+			hi[i] = lo[i-1]
+		}
+		if lo[i] > hi[i] {
+			lo[i] = hi[i]
+		}
+	}
+	return lo, hi
+}
+
+// Validates a pair of series before computing a distance on them
+// Unlike the vector metrics, the series may have different lengths
+func validate(a vector.Vector, b vector.Vector) error {
+	if a.Length() == 0 || b.Length() == 0 {
+		return vector.ErrEmptyVector
+	}
+	for _, v := range []vector.Vector{a, b} {
+		for _, value := range v {
+			if math.IsNaN(value) {
+				return vector.ErrNaN
+			}
+		}
+	}
+	return nil
+}
+
+// Step is a pair of aligned indices (I into the first series, J into the second) on a warping path
+type Step struct {
+	I int
+	J int
+}
+
+// Computes the Dynamic Time Warping distance between two series of possibly different lengths
+// DTW finds the monotone alignment of the series minimising the sum of squared differences of aligned
+// elements, and returns the square root of that sum, so that it reduces to the Euclidean distance
+// when the window only allows the diagonal.
+// Time complexity : O(n*m), or O(n*w) with a window of width w
+// Reference: https://en.wikipedia.org/wiki/Dynamic_time_warping
+func DTW(a vector.Vector, b vector.Vector, w Window) float64 {
+	result, err := TryDTW(a, b, w)
+	if err != nil {
+		panic(err)
+	}
+	return result
+}
+
+// Computes the Dynamic Time Warping distance between two series, returning an error on invalid input
+func TryDTW(a vector.Vector, b vector.Vector, w Window) (float64, error) {
+	if err := validate(a, b); err != nil {
+		return 0, err
+	}
+	if err := w.check(); err != nil {
+		return 0, err
+	}
+
+	n, m := a.Length(), b.Length()
+	lo, hi := w.bounds(n, m)
+	inf := math.Inf(1)
+	prev, curr := make([]float64, m), make([]float64, m)
+	for j := range prev {
+		prev[j] = inf
+	}
+
+	for i := 0; i < n; i++ {
+		for j := range curr {
+			curr[j] = inf
+		}
+		for j := lo[i]; j <= hi[i]; j++ {
+			d := a[i] - b[j]
+			curr[j] = d*d + bestPredecessor(prev, curr, i, j)
+		}
+		prev, curr = curr, prev
+	}
+	return math.Sqrt(prev[m-1]), nil
+}
+
+// Returns the cheapest of the three cells a warping path can reach (i, j) from
+func bestPredecessor(prev []float64, curr []float64, i int, j int) float64 {
+	if i == 0 && j == 0 {
+		return 0
+	}
+	best := math.Inf(1)
+	if i > 0 {
+		best = prev[j]
+		if j > 0 {
+			best = math.Min(best, prev[j-1])
+		}
+	}
+	if j > 0 {
+		best = math.Min(best, curr[j-1])
+	}
+	return best
+}
+
+// Computes the Dynamic Time Warping distance between two series along with the optimal warping path
+// The path starts at (0, 0), ends at (n-1, m-1) and advances I, J or both by one at every step.
+func DTWPath(a vector.Vector, b vector.Vector, w Window) (float64, []Step) {
+	result, path, err := TryDTWPath(a, b, w)
+	if err != nil {
+		panic(err)
+	}
+	return result, path
+}
+
+// Computes the Dynamic Time Warping distance and warping path, returning an error on invalid input
+// Time and memory complexity : O(n*m)
+func TryDTWPath(a vector.Vector, b vector.Vector, w Window) (float64, []Step, error) {
+	if err := validate(a, b); err != nil {
+		return 0, nil, err
+	}
+	if err := w.check(); err != nil {
+		return 0, nil, err
+	}
+
+	n, m := a.Length(), b.Length()
+	lo, hi := w.bounds(n, m)
+	inf := math.Inf(1)
+	cost := make([][]float64, n)
+	for i := range cost {
+		cost[i] = make([]float64, m)
+		for j := range cost[i] {
+			cost[i][j] = inf
+		}
+		var prev []float64
+		if i > 0 {
+			prev = cost[i-1]
+		}
+		for j := lo[i]; j <= hi[i]; j++ {
+			d := a[i] - b[j]
+			cost[i][j] = d*d + bestPredecessor(prev, cost[i], i, j)
+		}
+	}
+
+	// Walk back from the end, always moving to the cheapest predecessor (preferring the diagonal on ties)
+	path := []Step{{I: n - 1, J: m - 1}}
+	for i, j := n-1, m-1; i > 0 || j > 0; {
+		switch {
+		case i == 0:
+			j--
+		case j == 0:
+			i--
+		default:
+			diagonal, up, left := cost[i-1][j-1], cost[i-1][j], cost[i][j-1]
+			if diagonal <= up && diagonal <= left {
+				i, j = i-1, j-1
+			} else if up <= left {
+				i--
+			} else {
+				j--
+			}
+		}
+		path = append(path, Step{I: i, J: j})
+	}
+	for l, r := 0, len(path)-1; l < r; l, r = l+1, r-1 {
+		path[l], path[r] = path[r], path[l]
+	}
+	return math.Sqrt(cost[n-1][m-1]), path, nil
+}
+
+// Envelope holds the running maximum and minimum of a series over a window of +/- radius elements,
+// which LB_Keogh compares candidates against
+type Envelope struct {
+	Upper vector.Vector
+	Lower vector.Vector
+}
+
+// Computes the envelope of a series for a Sakoe-Chiba radius
+// Time complexity : O(n), using monotone queues of the window maxima and minima
+func NewEnvelope(q vector.Vector, radius int) Envelope {
+	if radius < 0 {
+		panic(vector.ErrInvalidParameter)
+	}
+
+	n := q.Length()
+	e := Envelope{Upper: make(vector.Vector, n), Lower: make(vector.Vector, n)}
+	var maxq, minq []int
+	next := 0
+	for i := 0; i < n; i++ {
+		// Extend the window to i + radius
+		for ; next < n && next <= i+radius; next++ {
+			for len(maxq) > 0 && q[maxq[len(maxq)-1]] <= q[next] {
+				maxq = maxq[:len(maxq)-1]
+			}
+			maxq = append(maxq, next)
+			for len(minq) > 0 && q[minq[len(minq)-1]] >= q[next] {
+				minq = minq[:len(minq)-1]
+			}
+			minq = append(minq, next)
+		}
+		// and shrink it from i - radius
+		for maxq[0] < i-radius {
+			maxq = maxq[1:]
+		}
+		for minq[0] < i-radius {
+			minq = minq[1:]
+		}
+		e.Upper[i], e.Lower[i] = q[maxq[0]], q[minq[0]]
+	}
+	return e
+}
+
+// Computes the LB_Keogh lower bound of the DTW distance between the series of the envelope and a candidate
+// of the same length, under the Sakoe-Chiba window the envelope was computed with
+func (e Envelope) LBKeogh(c vector.Vector) float64 {
+	if c.Length() != e.Upper.Length() {
+		panic(vector.ErrDimensionMismatch)
+	}
+
+	var result float64 = 0
+	for i, value := range c {
+		if value > e.Upper[i] {
+			d := value - e.Upper[i]
+			result += d * d
+		} else if value < e.Lower[i] {
+			d := e.Lower[i] - value
+			result += d * d
+		}
+	}
+	return math.Sqrt(result)
+}
+
+// Computes the LB_Keogh lower bound of DTW(q, c, SakoeChiba(radius)) for two series of the same length
+// LB_Keogh(q,c) = sqrt(sum of the squared distances of c_i outside the envelope of q) <= DTW(q,c)
+// so candidates whose bound exceeds the best distance found so far can be skipped without running DTW.
+// Reference: Keogh and Ratanamahatana, "Exact indexing of dynamic time warping", 2005
+func LBKeogh(q vector.Vector, c vector.Vector, radius int) float64 {
+	result, err := TryLBKeogh(q, c, radius)
+	if err != nil {
+		panic(err)
+	}
+	return result
+}
+
+// Computes the LB_Keogh lower bound, returning an error on invalid input
+func TryLBKeogh(q vector.Vector, c vector.Vector, radius int) (float64, error) {
+	if err := validate(q, c); err != nil {
+		return 0, err
+	}
+	if q.Length() != c.Length() {
+		return 0, vector.ErrDimensionMismatch
+	}
+	if radius < 0 {
+		return 0, vector.ErrInvalidParameter
+	}
+	return NewEnvelope(q, radius).LBKeogh(c), nil
+}
+
+// Returns the index of the candidate closest to the query under DTW with a Sakoe-Chiba radius, and its distance
+// Candidates of the same length as the query are first checked against LB_Keogh, and DTW is only
+// computed for those whose lower bound is below the best distance found so far.
+func DTWNearest(q vector.Vector, candidates []vector.Vector, radius int) (int, float64, error) {
+	if len(candidates) == 0 {
+		return -1, 0, vector.ErrEmptyVector
+	}
+	if radius < 0 {
+		return -1, 0, vector.ErrInvalidParameter
+	}
+
+	envelope := NewEnvelope(q, radius)
+	best, bestDistance := -1, math.Inf(1)
+	for i, c := range candidates {
+		if c.Length() == q.Length() && best >= 0 && envelope.LBKeogh(c) >= bestDistance {
+			continue
+		}
+		d, err := TryDTW(q, c, SakoeChiba(radius))
+		if err != nil {
+			return -1, 0, err
+		}
+		if d < bestDistance || best < 0 {
+			best, bestDistance = i, d
+		}
+	}
+	return best, bestDistance, nil
+}
+
+func minInt(a int, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func maxInt(a int, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,196 @@
+package timeseries
+
+import (
+	"math"
+	"math/rand"
+	"reflect"
+	"testing"
+
+	"github.com/rexsimiloluwah/distance_metrics/vector"
+)
+
+const floatDifferenceThresh = 1e-4
+
+// Returns a random walk of length n
+func randomWalk(rng *rand.Rand, n int) vector.Vector {
+	v := make(vector.Vector, n)
+	for i := 1; i < n; i++ {
+		v[i] = v[i-1] + rng.NormFloat64()
+	}
+	return v
+}
+
+// Computes the unconstrained DTW distance by plain recursion over the full cost matrix
+func naiveDTW(a vector.Vector, b vector.Vector) float64 {
+	cost := make([][]float64, len(a)+1)
+	for i := range cost {
+		cost[i] = make([]float64, len(b)+1)
+		for j := range cost[i] {
+			cost[i][j] = math.Inf(1)
+		}
+	}
+	cost[0][0] = 0
+	for i := 1; i <= len(a); i++ {
+		for j := 1; j <= len(b); j++ {
+			d := a[i-1] - b[j-1]
+			cost[i][j] = d*d + math.Min(cost[i-1][j-1], math.Min(cost[i-1][j], cost[i][j-1]))
+		}
+	}
+	return math.Sqrt(cost[len(a)][len(b)])
+}
+
+func TestDTW(t *testing.T) {
+	var tests = []struct {
+		a        vector.Vector
+		b        vector.Vector
+		w        Window
+		expected float64
+	}{
+		{vector.Vector{1, 2, 3}, vector.Vector{1, 2, 2, 3}, Window{}, 0},
+		{vector.Vector{0, 0, 0}, vector.Vector{1, 1, 1}, Window{}, math.Sqrt(3)},
+		{vector.Vector{0, 1, 0, 0}, vector.Vector{0, 0, 1, 0}, Window{}, 0},
+		{vector.Vector{0, 1, 0, 0}, vector.Vector{0, 0, 1, 0}, SakoeChiba(0), math.Sqrt(2)},
+		{vector.Vector{5}, vector.Vector{1, 2, 3}, Window{}, math.Sqrt(16 + 9 + 4)},
+	}
+
+	for _, test := range tests {
+		if output := DTW(test.a, test.b, test.w); math.Abs(output-test.expected) > floatDifferenceThresh {
+			t.Error("Test Failed,", test.expected, " expected,", output, " received.")
+		}
+	}
+}
+
+func TestDTWWindows(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for trial := 0; trial < 30; trial++ {
+		a, b := randomWalk(rng, 5+rng.Intn(30)), randomWalk(rng, 5+rng.Intn(30))
+		full := DTW(a, b, Window{})
+		if expected := naiveDTW(a, b); math.Abs(full-expected) > floatDifferenceThresh {
+			t.Error("Test Failed,", expected, " expected,", full, " received.")
+		}
+
+		// Wider windows can only lower the distance, down to the unconstrained one
+		previous := math.Inf(1)
+		for _, radius := range []int{0, 1, 3, 40} {
+			d := DTW(a, b, SakoeChiba(radius))
+			if math.IsInf(d, 1) || d > previous+floatDifferenceThresh || d < full-floatDifferenceThresh {
+				t.Error("Test Failed, radius", radius, "distance between", full, "and", previous, " expected,", d, " received.")
+			}
+			previous = d
+		}
+		if d := DTW(a, b, SakoeChiba(40)); math.Abs(d-full) > floatDifferenceThresh {
+			t.Error("Test Failed,", full, " expected,", d, " received.")
+		}
+
+		for _, slope := range []float64{1, 1.5, 2, 4} {
+			if d := DTW(a, b, Itakura(slope)); math.IsInf(d, 1) || d < full-floatDifferenceThresh {
+				t.Error("Test Failed, slope", slope, "distance of at least", full, " expected,", d, " received.")
+			}
+		}
+	}
+
+	// On equal lengths a zero radius only allows the diagonal
+	a, b := randomWalk(rng, 20), randomWalk(rng, 20)
+	if output, expected := DTW(a, b, SakoeChiba(0)), vector.Euclidean(a, b); math.Abs(output-expected) > floatDifferenceThresh {
+		t.Error("Test Failed,", expected, " expected,", output, " received.")
+	}
+}
+
+func TestDTWPath(t *testing.T) {
+	distance, path := DTWPath(vector.Vector{1, 2, 3}, vector.Vector{1, 2, 2, 3}, Window{})
+	if expected := []Step{{0, 0}, {1, 1}, {1, 2}, {2, 3}}; distance != 0 || !reflect.DeepEqual(path, expected) {
+		t.Error("Test Failed,", expected, " expected,", path, " received.")
+	}
+
+	rng := rand.New(rand.NewSource(2))
+	for trial := 0; trial < 20; trial++ {
+		a, b := randomWalk(rng, 5+rng.Intn(20)), randomWalk(rng, 5+rng.Intn(20))
+		w := SakoeChiba(rng.Intn(5))
+		distance, path := DTWPath(a, b, w)
+		if expected := DTW(a, b, w); math.Abs(distance-expected) > floatDifferenceThresh {
+			t.Error("Test Failed,", expected, " expected,", distance, " received.")
+		}
+
+		// The path must be a valid warping path whose cost is the distance
+		if path[0] != (Step{0, 0}) || path[len(path)-1] != (Step{a.Length() - 1, b.Length() - 1}) {
+			t.Error("Test Failed, path from the first to the last elements expected,", path, " received.")
+		}
+		var cost float64 = 0
+		for k, s := range path {
+			if k > 0 {
+				di, dj := s.I-path[k-1].I, s.J-path[k-1].J
+				if di < 0 || dj < 0 || di > 1 || dj > 1 || di+dj == 0 {
+					t.Error("Test Failed, invalid step from", path[k-1], "to", s)
+				}
+			}
+			d := a[s.I] - b[s.J]
+			cost += d * d
+		}
+		if math.Abs(math.Sqrt(cost)-distance) > floatDifferenceThresh {
+			t.Error("Test Failed,", distance, " expected,", math.Sqrt(cost), " received.")
+		}
+	}
+}
+
+func TestLBKeogh(t *testing.T) {
+	e := NewEnvelope(vector.Vector{1, 3, 2, 5, 4}, 1)
+	if expected := (vector.Vector{3, 3, 5, 5, 5}); !reflect.DeepEqual(e.Upper, expected) {
+		t.Error("Test Failed,", expected, " expected,", e.Upper, " received.")
+	}
+	if expected := (vector.Vector{1, 1, 2, 2, 4}); !reflect.DeepEqual(e.Lower, expected) {
+		t.Error("Test Failed,", expected, " expected,", e.Lower, " received.")
+	}
+
+	rng := rand.New(rand.NewSource(3))
+	for trial := 0; trial < 50; trial++ {
+		q, c := randomWalk(rng, 32), randomWalk(rng, 32)
+		radius := rng.Intn(6)
+		if lb, d := LBKeogh(q, c, radius), DTW(q, c, SakoeChiba(radius)); lb > d+floatDifferenceThresh {
+			t.Error("Test Failed, lower bound below", d, " expected,", lb, " received.")
+		}
+	}
+}
+
+func TestDTWNearest(t *testing.T) {
+	rng := rand.New(rand.NewSource(4))
+	q := randomWalk(rng, 40)
+	candidates := make([]vector.Vector, 100)
+	for i := range candidates {
+		candidates[i] = randomWalk(rng, 40)
+	}
+	candidates[42] = randomWalk(rng, 35) // lengths may differ
+
+	expected, expectedDistance := -1, math.Inf(1)
+	for i, c := range candidates {
+		if d := DTW(q, c, SakoeChiba(4)); d < expectedDistance {
+			expected, expectedDistance = i, d
+		}
+	}
+	index, distance, err := DTWNearest(q, candidates, 4)
+	if err != nil || index != expected || math.Abs(distance-expectedDistance) > floatDifferenceThresh {
+		t.Error("Test Failed,", expected, expectedDistance, " expected,", index, distance, " received.")
+	}
+}
+
+func TestDTWErrors(t *testing.T) {
+	var tests = []struct {
+		a        vector.Vector
+		b        vector.Vector
+		w        Window
+		expected error
+	}{
+		{vector.Vector{}, vector.Vector{1}, Window{}, vector.ErrEmptyVector},
+		{vector.Vector{math.NaN()}, vector.Vector{1}, Window{}, vector.ErrNaN},
+		{vector.Vector{1}, vector.Vector{1}, SakoeChiba(-1), vector.ErrInvalidParameter},
+		{vector.Vector{1}, vector.Vector{1}, Itakura(0.5), vector.ErrInvalidParameter},
+	}
+
+	for _, test := range tests {
+		if _, err := TryDTW(test.a, test.b, test.w); err != test.expected {
+			t.Error("Test Failed,", test.expected, " expected,", err, " received.")
+		}
+	}
+	if _, err := TryLBKeogh(vector.Vector{1, 2}, vector.Vector{1}, 1); err != vector.ErrDimensionMismatch {
+		t.Error("Test Failed,", vector.ErrDimensionMismatch, " expected,", err, " received.")
+	}
+}
@@ -0,0 +1,150 @@
+package timeseries
+
+import (
+	"math"
+
+	"github.com/rexsimiloluwah/distance_metrics/vector"
+)
+
+// Computes the Longest Common SubSequence similarity between two series
+// Two elements match if they differ by at most epsilon and their indices by at most delta (delta < 0 for no limit).
+// The length of the longest sequence of matches is divided by the length of the shorter series,
+// so the similarity ranges between 0 and 1 and ignores outliers that have no match.
+// Reference: Vlachos, Kollios and Gunopulos, "Discovering similar multidimensional trajectories", 2002
+func LCSS(a vector.Vector, b vector.Vector, epsilon float64, delta int) float64 {
+	result, err := TryLCSS(a, b, epsilon, delta)
+	if err != nil {
+		panic(err)
+	}
+	return result
+}
+
+// Computes the LCSS similarity between two series, returning an error on invalid input
+// ErrInvalidParameter is returned if epsilon is negative
+func TryLCSS(a vector.Vector, b vector.Vector, epsilon float64, delta int) (float64, error) {
+	if err := validate(a, b); err != nil {
+		return 0, err
+	}
+	if !(epsilon >= 0) {
+		return 0, vector.ErrInvalidParameter
+	}
+
+	n, m := a.Length(), b.Length()
+	prev, curr := make([]int, m+1), make([]int, m+1)
+	for i := 1; i <= n; i++ {
+		for j := 1; j <= m; j++ {
+			inWindow := delta < 0 || absInt(i-j) <= delta
+			if inWindow && math.Abs(a[i-1]-b[j-1]) <= epsilon {
+				curr[j] = prev[j-1] + 1
+			} else {
+				curr[j] = maxInt(prev[j], curr[j-1])
+			}
+		}
+		prev, curr = curr, prev
+	}
+	return float64(prev[m]) / float64(minInt(n, m)), nil
+}
+
+// Computes the LCSS distance between two series, i.e. 1 - LCSS(a,b)
+func LCSSDistance(a vector.Vector, b vector.Vector, epsilon float64, delta int) float64 {
+	result, err := TryLCSSDistance(a, b, epsilon, delta)
+	if err != nil {
+		panic(err)
+	}
+	return result
+}
+
+// Computes the LCSS distance between two series, returning an error on invalid input
+func TryLCSSDistance(a vector.Vector, b vector.Vector, epsilon float64, delta int) (float64, error) {
+	similarity, err := TryLCSS(a, b, epsilon, delta)
+	if err != nil {
+		return 0, err
+	}
+	return 1 - similarity, nil
+}
+
+// Computes the Edit distance with Real Penalty between two series
+// Aligned elements cost their absolute difference, and an element left unaligned (a gap)
+// costs its absolute difference to the constant gap value g, commonly 0 for normalised series.
+// Unlike DTW and LCSS, ERP is a true metric.
+// Reference: Chen and Ng, "On the marriage of Lp-norms and edit distance", 2004
+func ERP(a vector.Vector, b vector.Vector, g float64) float64 {
+	result, err := TryERP(a, b, g)
+	if err != nil {
+		panic(err)
+	}
+	return result
+}
+
+// Computes the Edit distance with Real Penalty between two series, returning an error on invalid input
+func TryERP(a vector.Vector, b vector.Vector, g float64) (float64, error) {
+	if err := validate(a, b); err != nil {
+		return 0, err
+	}
+	if math.IsNaN(g) {
+		return 0, vector.ErrNaN
+	}
+
+	n, m := a.Length(), b.Length()
+	prev, curr := make([]float64, m+1), make([]float64, m+1)
+	for j := 1; j <= m; j++ {
+		prev[j] = prev[j-1] + math.Abs(b[j-1]-g)
+	}
+	for i := 1; i <= n; i++ {
+		curr[0] = prev[0] + math.Abs(a[i-1]-g)
+		for j := 1; j <= m; j++ {
+			curr[j] = math.Min(prev[j-1]+math.Abs(a[i-1]-b[j-1]),
+				math.Min(prev[j]+math.Abs(a[i-1]-g), curr[j-1]+math.Abs(b[j-1]-g)))
+		}
+		prev, curr = curr, prev
+	}
+	return prev[m], nil
+}
+
+// Computes the Edit Distance on Real sequences between two series
+// EDR is the Levenshtein distance where two elements are equal if they differ by at most epsilon:
+// the minimum number of substitutions, insertions and deletions turning one series into the other.
+// Reference: Chen, Özsu and Oria, "Robust and fast similarity search for moving object trajectories", 2005
+func EDR(a vector.Vector, b vector.Vector, epsilon float64) int {
+	result, err := TryEDR(a, b, epsilon)
+	if err != nil {
+		panic(err)
+	}
+	return result
+}
+
+// Computes the Edit Distance on Real sequences between two series, returning an error on invalid input
+// ErrInvalidParameter is returned if epsilon is negative
+func TryEDR(a vector.Vector, b vector.Vector, epsilon float64) (int, error) {
+	if err := validate(a, b); err != nil {
+		return 0, err
+	}
+	if !(epsilon >= 0) {
+		return 0, vector.ErrInvalidParameter
+	}
+
+	n, m := a.Length(), b.Length()
+	prev, curr := make([]int, m+1), make([]int, m+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= n; i++ {
+		curr[0] = i
+		for j := 1; j <= m; j++ {
+			substitution := 1
+			if math.Abs(a[i-1]-b[j-1]) <= epsilon {
+				substitution = 0
+			}
+			curr[j] = minInt(prev[j-1]+substitution, minInt(prev[j], curr[j-1])+1)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[m], nil
+}
+
+func absInt(a int) int {
+	if a < 0 {
+		return -a
+	}
+	return a
+}
@@ -0,0 +1,95 @@
+package timeseries
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/rexsimiloluwah/distance_metrics/vector"
+)
+
+func TestLCSS(t *testing.T) {
+	var tests = []struct {
+		a        vector.Vector
+		b        vector.Vector
+		epsilon  float64
+		delta    int
+		expected float64
+	}{
+		{vector.Vector{1, 2, 3}, vector.Vector{1, 2, 3}, 0, -1, 1},
+		{vector.Vector{1, 2, 100, 3}, vector.Vector{1.1, 2.1, 3.1}, 0.2, -1, 1},
+		{vector.Vector{1, 2, 3, 4}, vector.Vector{5, 6}, 0.5, -1, 0},
+		{vector.Vector{1, 2, 3, 4}, vector.Vector{3, 4, 1, 2}, 0, -1, 0.5},
+		{vector.Vector{0, 0, 0, 1, 2}, vector.Vector{1, 2, 0, 0, 0}, 0, 1, 0.4},
+	}
+
+	for _, test := range tests {
+		if output := LCSS(test.a, test.b, test.epsilon, test.delta); math.Abs(output-test.expected) > floatDifferenceThresh {
+			t.Error("Test Failed,", test.expected, " expected,", output, " received.")
+		}
+	}
+	if output := LCSSDistance(vector.Vector{1, 2}, vector.Vector{1, 5}, 0, -1); math.Abs(output-0.5) > floatDifferenceThresh {
+		t.Error("Test Failed,", 0.5, " expected,", output, " received.")
+	}
+	if _, err := TryLCSS(vector.Vector{1}, vector.Vector{1}, -1, 0); err != vector.ErrInvalidParameter {
+		t.Error("Test Failed,", vector.ErrInvalidParameter, " expected,", err, " received.")
+	}
+	if _, err := TryLCSSDistance(vector.Vector{1}, vector.Vector{1}, -1, 0); err != vector.ErrInvalidParameter {
+		t.Error("Test Failed,", vector.ErrInvalidParameter, " expected,", err, " received.")
+	}
+	if _, err := TryLCSSDistance(vector.Vector{}, vector.Vector{1}, 0, 0); err != vector.ErrEmptyVector {
+		t.Error("Test Failed,", vector.ErrEmptyVector, " expected,", err, " received.")
+	}
+}
+
+func TestERP(t *testing.T) {
+	var tests = []struct {
+		a        vector.Vector
+		b        vector.Vector
+		g        float64
+		expected float64
+	}{
+		{vector.Vector{1, 2, 3}, vector.Vector{1, 2, 3}, 0, 0},
+		{vector.Vector{1, 2, 3}, vector.Vector{1, 3}, 0, 2},
+		{vector.Vector{1, 2, 3}, vector.Vector{1, 3}, 2, 0},
+		{vector.Vector{4}, vector.Vector{1, 1}, 0, 4},
+	}
+
+	for _, test := range tests {
+		if output := ERP(test.a, test.b, test.g); math.Abs(output-test.expected) > floatDifferenceThresh {
+			t.Error("Test Failed,", test.expected, " expected,", output, " received.")
+		}
+	}
+
+	// ERP is a metric, so it satisfies the triangle inequality
+	rng := rand.New(rand.NewSource(5))
+	for trial := 0; trial < 50; trial++ {
+		a, b, c := randomWalk(rng, 3+rng.Intn(10)), randomWalk(rng, 3+rng.Intn(10)), randomWalk(rng, 3+rng.Intn(10))
+		if ERP(a, c, 0) > ERP(a, b, 0)+ERP(b, c, 0)+floatDifferenceThresh {
+			t.Error("Test Failed, triangle inequality violated for", a, b, c)
+		}
+	}
+}
+
+func TestEDR(t *testing.T) {
+	var tests = []struct {
+		a        vector.Vector
+		b        vector.Vector
+		epsilon  float64
+		expected int
+	}{
+		{vector.Vector{1, 2, 3}, vector.Vector{1.1, 2.1, 2.9}, 0.2, 0},
+		{vector.Vector{1, 2, 3}, vector.Vector{1, 3}, 0, 1},
+		{vector.Vector{1, 2, 3}, vector.Vector{4, 5, 6}, 0.5, 3},
+		{vector.Vector{1, 2, 3, 4}, vector.Vector{2, 3, 4, 5}, 0, 2},
+	}
+
+	for _, test := range tests {
+		if output := EDR(test.a, test.b, test.epsilon); output != test.expected {
+			t.Error("Test Failed,", test.expected, " expected,", output, " received.")
+		}
+	}
+	if _, err := TryEDR(vector.Vector{}, vector.Vector{1}, 0); err != vector.ErrEmptyVector {
+		t.Error("Test Failed,", vector.ErrEmptyVector, " expected,", err, " received.")
+	}
+}
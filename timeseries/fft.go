@@ -0,0 +1,72 @@
+package timeseries
+
+import (
+	"math"
+	"math/bits"
+	"math/cmplx"
+)
+
+// Computes the discrete Fourier transform of x in place, or its inverse (without the 1/n scaling)
+// The length of x must be a power of two.
+// Time complexity : O(n log n), using the iterative radix-2 Cooley-Tukey algorithm
+func fft(x []complex128, inverse bool) {
+	n := len(x)
+	if n <= 1 {
+		return
+	}
+
+	// Reorder the input by bit-reversed index
+	shift := 64 - bits.TrailingZeros(uint(n))
+	for i := range x {
+		if j := int(bits.Reverse64(uint64(i)) >> shift); i < j {
+			x[i], x[j] = x[j], x[i]
+		}
+	}
+
+	sign := -1.0
+	if inverse {
+		sign = 1
+	}
+	for size := 2; size <= n; size <<= 1 {
+		step := cmplx.Rect(1, sign*2*math.Pi/float64(size))
+		for start := 0; start < n; start += size {
+			w := complex(1, 0)
+			for k := 0; k < size/2; k++ {
+				even, odd := x[start+k], w*x[start+k+size/2]
+				x[start+k], x[start+k+size/2] = even+odd, even-odd
+				w *= step
+			}
+		}
+	}
+}
+
+// Computes the dot products of the query with every window of the series of the same length
+// Entry i of the result is sum(q[k] * t[i+k]) for k in [0, m), for i in [0, n-m].
+// Time complexity : O(n log n), as a convolution of the series with the reversed query
+func slidingDotProduct(q []float64, t []float64) []float64 {
+	n, m := len(t), len(q)
+	size := 1
+	for size < n+m {
+		size <<= 1
+	}
+
+	a, b := make([]complex128, size), make([]complex128, size)
+	for i, value := range t {
+		a[i] = complex(value, 0)
+	}
+	for i, value := range q {
+		b[m-1-i] = complex(value, 0)
+	}
+	fft(a, false)
+	fft(b, false)
+	for i := range a {
+		a[i] *= b[i]
+	}
+	fft(a, true)
+
+	result := make([]float64, n-m+1)
+	for i := range result {
+		result[i] = real(a[m-1+i]) / float64(size)
+	}
+	return result
+}
@@ -0,0 +1,118 @@
+package timeseries
+
+import (
+	"math"
+
+	"github.com/rexsimiloluwah/distance_metrics/vector"
+)
+
+// Computes the mean and population standard deviation of every window of length m of a series
+// Time complexity : O(n), from running sums of the values and squared values centred on the
+// mean of the series to limit cancellation
+func slidingStats(t vector.Vector, m int) (vector.Vector, vector.Vector) {
+	n := t.Length()
+	center := t.Mean()
+	means, stdevs := make(vector.Vector, n-m+1), make(vector.Vector, n-m+1)
+
+	var sum, squares float64
+	for i := 0; i < n; i++ {
+		x := t[i] - center
+		sum += x
+		squares += x * x
+		if i >= m {
+			y := t[i-m] - center
+			sum -= y
+			squares -= y * y
+		}
+		if i >= m-1 {
+			mean := sum / float64(m)
+			means[i-m+1] = mean + center
+			stdevs[i-m+1] = math.Sqrt(math.Max(0, squares/float64(m)-mean*mean))
+		}
+	}
+	return means, stdevs
+}
+
+// Standard deviations below this fraction of the scale of the series are treated as constant windows
+const constantTolerance = 1e-8
+
+// Computes the z-normalised Euclidean distance between two windows of length m from their dot product,
+// means and standard deviations: sqrt(2m * (1 - (qt - m*mq*mt) / (m*sq*st)))
+// A constant window is at distance 0 from another constant window and sqrt(m) from any other window.
+func znormDistance(qt float64, m int, mq float64, sq float64, mt float64, st float64, tol float64) float64 {
+	qconst, tconst := sq <= tol, st <= tol
+	switch {
+	case qconst && tconst:
+		return 0
+	case qconst || tconst:
+		return math.Sqrt(float64(m))
+	}
+	correlation := (qt - float64(m)*mq*mt) / (float64(m) * sq * st)
+	return math.Sqrt(math.Max(0, 2*float64(m)*(1-correlation)))
+}
+
+// Validates a series and a window length before a subsequence search
+func validateWindow(t vector.Vector, m int) error {
+	if t.Length() == 0 {
+		return vector.ErrEmptyVector
+	}
+	for _, value := range t {
+		if math.IsNaN(value) {
+			return vector.ErrNaN
+		}
+	}
+	if m < 2 || m > t.Length() {
+		return vector.ErrInvalidParameter
+	}
+	return nil
+}
+
+// Computes the distance profile of a query over a series with MASS
+// Entry i of the result is the Euclidean distance between the z-normalised query and the z-normalised
+// window t[i : i+m], where m is the length of the query, so the smallest entry locates the best match
+// of the shape of the query regardless of offset and scale.
+// Time complexity : O(n log n), computing all the dot products at once with the FFT
+// Reference: Mueen et al., "The Fastest Similarity Search Algorithm for Time Series Subsequences under
+// Euclidean Distance", https://www.cs.unm.edu/~mueen/FastestSimilaritySearch.html
+func MASS(q vector.Vector, t vector.Vector) vector.Vector {
+	result, err := TryMASS(q, t)
+	if err != nil {
+		panic(err)
+	}
+	return result
+}
+
+// Computes the distance profile of a query over a series, returning an error on invalid input
+// ErrInvalidParameter is returned if the query has fewer than 2 elements or is longer than the series
+func TryMASS(q vector.Vector, t vector.Vector) (vector.Vector, error) {
+	if err := validateWindow(t, q.Length()); err != nil {
+		return nil, err
+	}
+	if err := validateWindow(q, q.Length()); err != nil {
+		return nil, err
+	}
+
+	m := q.Length()
+	mq, sq := q.Mean(), math.Sqrt(q.Stats().PopulationVariance())
+	means, stdevs := slidingStats(t, m)
+	qt := slidingDotProduct(q, t)
+	tol := constantTolerance * math.Max(scale(q), scale(t))
+
+	result := make(vector.Vector, len(qt))
+	for i := range qt {
+		result[i] = znormDistance(qt[i], m, mq, sq, means[i], stdevs[i], tol)
+	}
+	return result, nil
+}
+
+// Returns the largest absolute value of a series, or 1 if it is all zeros
+func scale(t vector.Vector) float64 {
+	result := 0.0
+	for _, value := range t {
+		result = math.Max(result, math.Abs(value))
+	}
+	if result == 0 {
+		return 1
+	}
+	return result
+}
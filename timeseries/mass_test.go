@@ -0,0 +1,88 @@
+package timeseries
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/rexsimiloluwah/distance_metrics/vector"
+)
+
+// Z-normalises a window with the population standard deviation
+func znormalize(v vector.Vector) vector.Vector {
+	mean, stdev := v.Mean(), math.Sqrt(v.Stats().PopulationVariance())
+	result := make(vector.Vector, v.Length())
+	for i, value := range v {
+		result[i] = (value - mean) / stdev
+	}
+	return result
+}
+
+func TestSlidingDotProduct(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for _, n := range []int{2, 7, 64, 100} {
+		series := randomWalk(rng, n)
+		m := 1 + rng.Intn(n)
+		output := slidingDotProduct(series[:m], series)
+		for i := range output {
+			expected := series[:m].Dot(series[i : i+m])
+			if math.Abs(output[i]-expected) > floatDifferenceThresh {
+				t.Error("Test Failed,", expected, " expected,", output[i], " received.")
+			}
+		}
+	}
+}
+
+func TestMASS(t *testing.T) {
+	rng := rand.New(rand.NewSource(2))
+	series := randomWalk(rng, 300)
+	q := randomWalk(rng, 25)
+
+	profile := MASS(q, series)
+	if profile.Length() != 300-25+1 {
+		t.Fatal("Test Failed,", 300-25+1, " expected,", profile.Length(), " received.")
+	}
+	zq := znormalize(q)
+	for i, output := range profile {
+		if expected := vector.Euclidean(zq, znormalize(series[i:i+25])); math.Abs(output-expected) > floatDifferenceThresh {
+			t.Error("Test Failed,", expected, " expected,", output, " received.")
+		}
+	}
+
+	// A scaled and shifted copy of the query planted in the series is found at distance 0
+	for i, value := range q {
+		series[140+i] = 3*value + 10
+	}
+	profile = MASS(q, series)
+	if best := profile.Minarg(); best != 140 || profile[best] > floatDifferenceThresh {
+		t.Error("Test Failed,", 140, " expected,", best, profile[best], " received.")
+	}
+}
+
+func TestMASSConstant(t *testing.T) {
+	series := vector.Vector{1, 1, 1, 1, 2, 3, 4}
+	profile := MASS(vector.Vector{5, 5, 5}, series)
+	expected := vector.Vector{0, 0, math.Sqrt(3), math.Sqrt(3), math.Sqrt(3)}
+	for i := range expected {
+		if math.Abs(profile[i]-expected[i]) > floatDifferenceThresh {
+			t.Error("Test Failed,", expected, " expected,", profile, " received.")
+			break
+		}
+	}
+
+	var tests = []struct {
+		q        vector.Vector
+		t        vector.Vector
+		expected error
+	}{
+		{vector.Vector{1}, series, vector.ErrInvalidParameter},
+		{series, vector.Vector{1, 2}, vector.ErrInvalidParameter},
+		{vector.Vector{1, math.NaN()}, series, vector.ErrNaN},
+		{vector.Vector{1, 2}, vector.Vector{}, vector.ErrEmptyVector},
+	}
+	for _, test := range tests {
+		if _, err := TryMASS(test.q, test.t); err != test.expected {
+			t.Error("Test Failed,", test.expected, " expected,", err, " received.")
+		}
+	}
+}
@@ -0,0 +1,100 @@
+package timeseries
+
+import (
+	"math"
+
+	"github.com/rexsimiloluwah/distance_metrics/vector"
+)
+
+// MatrixProfile holds, for every window of a series, the z-normalised Euclidean distance to its
+// nearest non-trivial match elsewhere in the series
+type MatrixProfile struct {
+	// Window is the length m of the compared subsequences
+	Window int
+	// Profile[i] is the distance between the window starting at i and its nearest neighbour
+	Profile vector.Vector
+	// Index[i] is the start of that nearest neighbour, or -1 if every other window is a trivial match
+	Index []int
+}
+
+// Computes the matrix profile of a series for a window length m with STOMP
+// Windows overlapping by more than 3m/4 (starting within ceil(m/4) of each other) are trivial matches and
+// are excluded. Low profile values mark repeated patterns (motifs), and high values mark anomalies (discords).
+// Time complexity : O(n^2), updating the dot products of consecutive windows in O(1) each
+// Reference: Zhu et al., "Matrix Profile II: Exploiting a Novel Algorithm and GPUs to break the one
+// Hundred Million Barrier for Time Series Motifs and Joins", 2016
+func STOMP(t vector.Vector, m int) *MatrixProfile {
+	result, err := TrySTOMP(t, m)
+	if err != nil {
+		panic(err)
+	}
+	return result
+}
+
+// Computes the matrix profile of a series, returning an error on invalid input
+// ErrInvalidParameter is returned if m < 2 or m > len(t)
+func TrySTOMP(t vector.Vector, m int) (*MatrixProfile, error) {
+	if err := validateWindow(t, m); err != nil {
+		return nil, err
+	}
+
+	k := t.Length() - m + 1
+	exclusion := (m + 3) / 4
+	means, stdevs := slidingStats(t, m)
+	tol := constantTolerance * scale(t)
+
+	p := &MatrixProfile{Window: m, Profile: make(vector.Vector, k), Index: make([]int, k)}
+	for i := range p.Profile {
+		p.Profile[i], p.Index[i] = math.Inf(1), -1
+	}
+
+	// The dot products of the first window with every window also give the first column
+	first := slidingDotProduct(t[:m], t)
+	qt := append([]float64(nil), first...)
+	for i := 0; i < k; i++ {
+		if i > 0 {
+			// Slide both windows by one: drop the products of their first elements and add their new last ones
+			for j := k - 1; j > 0; j-- {
+				qt[j] = qt[j-1] - t[i-1]*t[j-1] + t[i+m-1]*t[j+m-1]
+			}
+			qt[0] = first[i]
+		}
+
+		// By symmetry, each row only needs the windows after the exclusion zone, updating both ends
+		for j := i + exclusion; j < k; j++ {
+			d := znormDistance(qt[j], m, means[i], stdevs[i], means[j], stdevs[j], tol)
+			if d < p.Profile[i] {
+				p.Profile[i], p.Index[i] = d, j
+			}
+			if d < p.Profile[j] {
+				p.Profile[j], p.Index[j] = d, i
+			}
+		}
+	}
+	return p, nil
+}
+
+// Returns the start indices of the top motif, the pair of windows closest to each other
+func (p *MatrixProfile) Motif() (int, int) {
+	best := -1
+	for i, value := range p.Profile {
+		if p.Index[i] >= 0 && (best < 0 || value < p.Profile[best]) {
+			best = i
+		}
+	}
+	if best < 0 {
+		return -1, -1
+	}
+	return best, p.Index[best]
+}
+
+// Returns the start index of the top discord, the window farthest from its nearest neighbour
+func (p *MatrixProfile) Discord() int {
+	best := -1
+	for i, value := range p.Profile {
+		if p.Index[i] >= 0 && (best < 0 || value > p.Profile[best]) {
+			best = i
+		}
+	}
+	return best
+}
@@ -0,0 +1,68 @@
+package timeseries
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/rexsimiloluwah/distance_metrics/vector"
+)
+
+func TestSTOMP(t *testing.T) {
+	rng := rand.New(rand.NewSource(3))
+	series := randomWalk(rng, 200)
+	m := 16
+	p := STOMP(series, m)
+
+	// Compare against the profile built from one MASS call per window
+	exclusion := (m + 3) / 4
+	for i := range p.Profile {
+		distances := MASS(series[i:i+m], series)
+		expected, index := math.Inf(1), -1
+		for j, d := range distances {
+			if (j <= i-exclusion || j >= i+exclusion) && d < expected {
+				expected, index = d, j
+			}
+		}
+		if math.Abs(p.Profile[i]-expected) > floatDifferenceThresh {
+			t.Error("Test Failed,", expected, " expected,", p.Profile[i], " received.")
+		}
+		if p.Index[i] != index && math.Abs(distances[p.Index[i]]-expected) > floatDifferenceThresh {
+			t.Error("Test Failed,", index, " expected,", p.Index[i], " received.")
+		}
+	}
+}
+
+func TestMotifDiscord(t *testing.T) {
+	rng := rand.New(rand.NewSource(4))
+	series := make(vector.Vector, 400)
+	for i := range series {
+		series[i] = math.Sin(float64(i)/5) + 0.05*rng.NormFloat64()
+	}
+	// Plant a sharp anomaly
+	for i := 250; i < 260; i++ {
+		series[i] += 3 * math.Sin(float64(i))
+	}
+	// and a repeated pattern
+	pattern := randomWalk(rng, 20)
+	for i, value := range pattern {
+		series[50+i] = value
+		series[320+i] = value + 0.01*rng.NormFloat64()
+	}
+
+	p := STOMP(series, 20)
+	a, b := p.Motif()
+	if a > b {
+		a, b = b, a
+	}
+	if a != 50 || b != 320 {
+		t.Error("Test Failed,", 50, 320, " expected,", a, b, " received.")
+	}
+	if discord := p.Discord(); discord < 230 || discord > 260 {
+		t.Error("Test Failed, discord between", 230, "and", 260, " expected,", discord, " received.")
+	}
+
+	if _, err := TrySTOMP(series, 1); err != vector.ErrInvalidParameter {
+		t.Error("Test Failed,", vector.ErrInvalidParameter, " expected,", err, " received.")
+	}
+}
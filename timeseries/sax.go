@@ -0,0 +1,173 @@
+package timeseries
+
+import (
+	"math"
+
+	"github.com/rexsimiloluwah/distance_metrics/vector"
+)
+
+// Largest supported SAX alphabet, so that every symbol maps to a letter from 'a' to 'z'
+const MaxAlphabetSize = 26
+
+// Computes the Piecewise Aggregate Approximation of a series with the given number of segments
+// Each segment covers len(v)/segments elements and is replaced by their mean. When the length is not
+// a multiple of the number of segments, elements on a boundary are split between the two segments.
+// Reference: Keogh et al., "Dimensionality Reduction for Fast Similarity Search in Large Time Series Databases", 2001
+func PAA(v vector.Vector, segments int) vector.Vector {
+	result, err := TryPAA(v, segments)
+	if err != nil {
+		panic(err)
+	}
+	return result
+}
+
+// Computes the Piecewise Aggregate Approximation of a series, returning an error on invalid input
+// ErrInvalidParameter is returned if segments is not between 1 and len(v)
+func TryPAA(v vector.Vector, segments int) (vector.Vector, error) {
+	if v.Length() == 0 {
+		return nil, vector.ErrEmptyVector
+	}
+	if segments < 1 || segments > v.Length() {
+		return nil, vector.ErrInvalidParameter
+	}
+
+	n := v.Length()
+	result := make(vector.Vector, segments)
+	if n%segments == 0 {
+		size := n / segments
+		for s := range result {
+			result[s] = v[s*size : (s+1)*size].Mean()
+		}
+		return result, nil
+	}
+
+	// Stretch the series by a factor of segments so that every segment covers exactly n stretched elements
+	for k := 0; k < n*segments; k++ {
+		result[k/n] += v[k/segments]
+	}
+	for s := range result {
+		result[s] /= float64(n)
+	}
+	return result, nil
+}
+
+// Returns the a-1 breakpoints dividing the standard normal distribution into a equiprobable regions
+func breakpoints(a int) []float64 {
+	result := make([]float64, a-1)
+	for i := range result {
+		p := float64(i+1) / float64(a)
+		result[i] = math.Sqrt2 * math.Erfinv(2*p-1)
+	}
+	return result
+}
+
+// Word is the SAX representation of a series
+type Word struct {
+	// Symbols holds one symbol in [0, AlphabetSize) per segment, 0 for the lowest values
+	Symbols []int
+	// AlphabetSize is the number of symbols the series was discretised into
+	AlphabetSize int
+	// SeriesLength is the length of the original series, which scales MinDist
+	SeriesLength int
+}
+
+// Converts the word to a string of letters, 'a' for the lowest symbol, so that words can be
+// compared with the distances of the text package
+func (w Word) String() string {
+	letters := make([]byte, len(w.Symbols))
+	for i, symbol := range w.Symbols {
+		letters[i] = byte('a' + symbol)
+	}
+	return string(letters)
+}
+
+// Computes the Symbolic Aggregate approXimation of a series
+// The series is z-normalised, reduced to wordLength segments with PAA, and each segment mean is mapped
+// to one of alphabetSize symbols by the breakpoints splitting the standard normal distribution
+// into equiprobable regions. A constant series maps to the middle symbols.
+// Reference: Lin et al., "Experiencing SAX: a novel symbolic representation of time series", 2007
+func SAX(v vector.Vector, wordLength int, alphabetSize int) Word {
+	result, err := TrySAX(v, wordLength, alphabetSize)
+	if err != nil {
+		panic(err)
+	}
+	return result
+}
+
+// Computes the Symbolic Aggregate approXimation of a series, returning an error on invalid input
+// ErrInvalidParameter is returned if wordLength is not between 1 and len(v), or alphabetSize is not
+// between 2 and MaxAlphabetSize
+func TrySAX(v vector.Vector, wordLength int, alphabetSize int) (Word, error) {
+	if err := validate(v, v); err != nil {
+		return Word{}, err
+	}
+	if alphabetSize < 2 || alphabetSize > MaxAlphabetSize {
+		return Word{}, vector.ErrInvalidParameter
+	}
+
+	mean, stdev := v.Mean(), math.Sqrt(v.Stats().PopulationVariance())
+	normalized := make(vector.Vector, v.Length())
+	if stdev > constantTolerance*scale(v) {
+		for i, value := range v {
+			normalized[i] = (value - mean) / stdev
+		}
+	}
+	paa, err := TryPAA(normalized, wordLength)
+	if err != nil {
+		return Word{}, err
+	}
+
+	cuts := breakpoints(alphabetSize)
+	w := Word{Symbols: make([]int, wordLength), AlphabetSize: alphabetSize, SeriesLength: v.Length()}
+	for i, value := range paa {
+		symbol := 0
+		for symbol < len(cuts) && value >= cuts[symbol] {
+			symbol++
+		}
+		w.Symbols[i] = symbol
+	}
+	return w, nil
+}
+
+// Computes the MINDIST lower bound between two SAX words of the same shape
+// MINDIST(a,b) = sqrt(n/w) * sqrt(sum(cell(a_i, b_i)^2)), where cell is 0 for equal or adjacent
+// symbols and otherwise the gap between the breakpoints separating them. It never exceeds the
+// Euclidean distance between the z-normalised series, so it can prune candidates in an index.
+func MinDist(a Word, b Word) float64 {
+	result, err := TryMinDist(a, b)
+	if err != nil {
+		panic(err)
+	}
+	return result
+}
+
+// Computes the MINDIST lower bound between two SAX words, returning an error on invalid input
+// ErrDimensionMismatch is returned if the words differ in length, alphabet size or series length
+func TryMinDist(a Word, b Word) (float64, error) {
+	if len(a.Symbols) == 0 || len(b.Symbols) == 0 {
+		return 0, vector.ErrEmptyVector
+	}
+	if len(a.Symbols) != len(b.Symbols) || a.AlphabetSize != b.AlphabetSize || a.SeriesLength != b.SeriesLength {
+		return 0, vector.ErrDimensionMismatch
+	}
+	if a.AlphabetSize < 2 || a.AlphabetSize > MaxAlphabetSize {
+		return 0, vector.ErrInvalidParameter
+	}
+
+	cuts := breakpoints(a.AlphabetSize)
+	var result float64 = 0
+	for i := range a.Symbols {
+		lo, hi := a.Symbols[i], b.Symbols[i]
+		if lo > hi {
+			lo, hi = hi, lo
+		}
+		if lo < 0 || hi >= a.AlphabetSize {
+			return 0, vector.ErrIndexOutOfRange
+		}
+		if hi-lo > 1 {
+			d := cuts[hi-1] - cuts[lo]
+			result += d * d
+		}
+	}
+	return math.Sqrt(float64(a.SeriesLength)/float64(len(a.Symbols))) * math.Sqrt(result), nil
+}
@@ -0,0 +1,86 @@
+package timeseries
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/rexsimiloluwah/distance_metrics/text"
+	"github.com/rexsimiloluwah/distance_metrics/vector"
+)
+
+func TestPAA(t *testing.T) {
+	var tests = []struct {
+		v        vector.Vector
+		segments int
+		expected vector.Vector
+	}{
+		{vector.Vector{1, 2, 3, 4, 5, 6}, 3, vector.Vector{1.5, 3.5, 5.5}},
+		{vector.Vector{1, 2, 3, 4, 5, 6}, 6, vector.Vector{1, 2, 3, 4, 5, 6}},
+		{vector.Vector{1, 2, 3, 4, 5}, 2, vector.Vector{(1 + 2 + 1.5) / 2.5, (1.5 + 4 + 5) / 2.5}},
+		{vector.Vector{3, 6, 9}, 1, vector.Vector{6}},
+	}
+
+	for _, test := range tests {
+		output := PAA(test.v, test.segments)
+		for i := range test.expected {
+			if math.Abs(output[i]-test.expected[i]) > floatDifferenceThresh {
+				t.Error("Test Failed,", test.expected, " expected,", output, " received.")
+				break
+			}
+		}
+	}
+	if _, err := TryPAA(vector.Vector{1, 2}, 3); err != vector.ErrInvalidParameter {
+		t.Error("Test Failed,", vector.ErrInvalidParameter, " expected,", err, " received.")
+	}
+}
+
+func TestSAX(t *testing.T) {
+	if output, expected := breakpoints(4), []float64{-0.6745, 0, 0.6745}; math.Abs(output[0]-expected[0]) > floatDifferenceThresh ||
+		math.Abs(output[1]) > floatDifferenceThresh || math.Abs(output[2]-expected[2]) > floatDifferenceThresh {
+		t.Error("Test Failed,", expected, " expected,", output, " received.")
+	}
+
+	w := SAX(vector.Vector{-2, -2, -1, -1, 1, 1, 2, 2}, 4, 4)
+	if output := w.String(); output != "abcd" {
+		t.Error("Test Failed,", "abcd", " expected,", output, " received.")
+	}
+	if output := SAX(vector.Vector{7, 7, 7, 7}, 2, 3).String(); output != "bb" {
+		t.Error("Test Failed,", "bb", " expected,", output, " received.")
+	}
+
+	// Words are strings, so text distances apply to them
+	u := SAX(vector.Vector{2, 2, 1, 1, -1, -1, -2, -2}, 4, 4)
+	if output := text.Levensthein(w.String(), u.String()); output != 4 {
+		t.Error("Test Failed,", 4, " expected,", output, " received.")
+	}
+
+	if _, err := TrySAX(vector.Vector{1, 2}, 2, 27); err != vector.ErrInvalidParameter {
+		t.Error("Test Failed,", vector.ErrInvalidParameter, " expected,", err, " received.")
+	}
+}
+
+func TestMinDist(t *testing.T) {
+	a := Word{Symbols: []int{0, 1, 3}, AlphabetSize: 4, SeriesLength: 12}
+	b := Word{Symbols: []int{2, 2, 0}, AlphabetSize: 4, SeriesLength: 12}
+	// Only the first and last symbols are more than one apart
+	expected := 2 * math.Sqrt(0.6745*0.6745+2*0.6745*2*0.6745)
+	if output := MinDist(a, b); math.Abs(output-expected) > 1e-3 {
+		t.Error("Test Failed,", expected, " expected,", output, " received.")
+	}
+
+	// MINDIST lower bounds the Euclidean distance between the z-normalised series
+	rng := rand.New(rand.NewSource(5))
+	for trial := 0; trial < 100; trial++ {
+		x, y := randomWalk(rng, 64), randomWalk(rng, 64)
+		alphabet := 2 + rng.Intn(MaxAlphabetSize-1)
+		lb := MinDist(SAX(x, 8, alphabet), SAX(y, 8, alphabet))
+		if d := vector.Euclidean(znormalize(x), znormalize(y)); lb > d+floatDifferenceThresh {
+			t.Error("Test Failed, lower bound below", d, " expected,", lb, " received.")
+		}
+	}
+
+	if _, err := TryMinDist(a, Word{Symbols: []int{0, 1}, AlphabetSize: 4, SeriesLength: 12}); err != vector.ErrDimensionMismatch {
+		t.Error("Test Failed,", vector.ErrDimensionMismatch, " expected,", err, " received.")
+	}
+}
@@ -0,0 +1,298 @@
+package vector
+
+import (
+	"math/bits"
+)
+
+// Bits is a packed binary vector of length Len, storing 64 elements per word
+// Bit i is bit i%64 of Words[i/64]; the unused bits of the last word are always 0.
+type Bits struct {
+	Len   int
+	Words []uint64
+}
+
+// Initialize a new binary vector of length n with every bit unset
+func NewBits(n int) Bits {
+	if n < 0 {
+		panic(ErrInvalidParameter)
+	}
+	return Bits{Len: n, Words: make([]uint64, (n+63)/64)}
+}
+
+// Initialize a new binary vector from a slice of booleans
+func BitsFromBools(values []bool) Bits {
+	b := NewBits(len(values))
+	for i, value := range values {
+		if value {
+			b.Words[i/64] |= 1 << (i % 64)
+		}
+	}
+	return b
+}
+
+// Initialize a new binary vector from a vector, setting the bits of its non-zero elements
+func BitsFromVector(v Vector) Bits {
+	b := NewBits(v.Length())
+	for i, value := range v {
+		if value != 0 {
+			b.Words[i/64] |= 1 << (i % 64)
+		}
+	}
+	return b
+}
+
+// Returns the bit at a particular index
+func (b Bits) Get(index int) bool {
+	if index < 0 || index >= b.Len {
+		panic(ErrIndexOutOfRange)
+	}
+	return b.Words[index/64]&(1<<(index%64)) != 0
+}
+
+// Sets or clears the bit at a particular index
+func (b Bits) Set(index int, value bool) {
+	if index < 0 || index >= b.Len {
+		panic(ErrIndexOutOfRange)
+	}
+	if value {
+		b.Words[index/64] |= 1 << (index % 64)
+	} else {
+		b.Words[index/64] &^= 1 << (index % 64)
+	}
+}
+
+// Returns the number of set bits
+func (b Bits) Count() int {
+	count := 0
+	for _, w := range b.Words {
+		count += bits.OnesCount64(w)
+	}
+	return count
+}
+
+// Converts the binary vector into a vector of zeros and ones
+func (b Bits) ToVector() Vector {
+	v := make(Vector, b.Len)
+	for i := range v {
+		if b.Get(i) {
+			v[i] = 1
+		}
+	}
+	return v
+}
+
+// Contingency holds the 2x2 contingency counts of two binary vectors x and y
+// A counts positions where both bits are set, B where only x is set, C where only y is set and D where neither is
+type Contingency struct {
+	A int
+	B int
+	C int
+	D int
+}
+
+// Validates a pair of binary vectors before comparing them
+func validateBits(x Bits, y Bits) error {
+	if x.Len == 0 || y.Len == 0 {
+		return ErrEmptyVector
+	}
+	if x.Len != y.Len {
+		return ErrDimensionMismatch
+	}
+	return nil
+}
+
+// Computes the 2x2 contingency counts of two binary vectors
+func BinaryContingency(x Bits, y Bits) Contingency {
+	result, err := TryBinaryContingency(x, y)
+	if err != nil {
+		panic(err)
+	}
+	return result
+}
+
+// Computes the 2x2 contingency counts of two binary vectors, returning an error on invalid input
+func TryBinaryContingency(x Bits, y Bits) (Contingency, error) {
+	if err := validateBits(x, y); err != nil {
+		return Contingency{}, err
+	}
+
+	var c Contingency
+	for i := range x.Words {
+		c.A += bits.OnesCount64(x.Words[i] & y.Words[i])
+		c.B += bits.OnesCount64(x.Words[i] &^ y.Words[i])
+		c.C += bits.OnesCount64(y.Words[i] &^ x.Words[i])
+	}
+	c.D = x.Len - c.A - c.B - c.C
+	return c, nil
+}
+
+// Computes the Hamming distance between two binary vectors, the number of positions where the bits differ
+func BinaryHamming(x Bits, y Bits) int {
+	result, err := TryBinaryHamming(x, y)
+	if err != nil {
+		panic(err)
+	}
+	return result
+}
+
+// Computes the Hamming distance between two binary vectors, returning an error on invalid input
+func TryBinaryHamming(x Bits, y Bits) (int, error) {
+	if err := validateBits(x, y); err != nil {
+		return 0, err
+	}
+
+	count := 0
+	for i := range x.Words {
+		count += bits.OnesCount64(x.Words[i] ^ y.Words[i])
+	}
+	return count, nil
+}
+
+// Computes the Jaccard (Tanimoto) similarity between two binary vectors
+// Jaccard(x,y) = a / (a + b + c), which is 0 if neither vector has a set bit
+// This follows set.Jaccard: empty inputs have a similarity of 0, while set.JaccardDistance treats them as identical
+func BinaryJaccard(x Bits, y Bits) float64 {
+	result, err := TryBinaryJaccard(x, y)
+	if err != nil {
+		panic(err)
+	}
+	return result
+}
+
+// Computes the Jaccard similarity between two binary vectors, returning an error on invalid input
+func TryBinaryJaccard(x Bits, y Bits) (float64, error) {
+	c, err := TryBinaryContingency(x, y)
+	if err != nil {
+		return 0, err
+	}
+	if c.A+c.B+c.C == 0 {
+		return 0, nil
+	}
+	return float64(c.A) / float64(c.A+c.B+c.C), nil
+}
+
+// Computes the Dice similarity between two binary vectors
+// Dice(x,y) = 2a / (2a + b + c), which is 0 if neither vector has a set bit, like set.Sorensen of two empty sets
+func BinaryDice(x Bits, y Bits) float64 {
+	result, err := TryBinaryDice(x, y)
+	if err != nil {
+		panic(err)
+	}
+	return result
+}
+
+// Computes the Dice similarity between two binary vectors, returning an error on invalid input
+func TryBinaryDice(x Bits, y Bits) (float64, error) {
+	c, err := TryBinaryContingency(x, y)
+	if err != nil {
+		return 0, err
+	}
+	if c.A+c.B+c.C == 0 {
+		return 0, nil
+	}
+	return float64(2*c.A) / float64(2*c.A+c.B+c.C), nil
+}
+
+// Computes the Russell-Rao similarity between two binary vectors
+// RussellRao(x,y) = a / n, the fraction of positions where both bits are set
+func RussellRao(x Bits, y Bits) float64 {
+	result, err := TryRussellRao(x, y)
+	if err != nil {
+		panic(err)
+	}
+	return result
+}
+
+// Computes the Russell-Rao similarity between two binary vectors, returning an error on invalid input
+func TryRussellRao(x Bits, y Bits) (float64, error) {
+	c, err := TryBinaryContingency(x, y)
+	if err != nil {
+		return 0, err
+	}
+	return float64(c.A) / float64(x.Len), nil
+}
+
+// Computes the Sokal-Michener (simple matching) similarity between two binary vectors
+// SokalMichener(x,y) = (a + d) / n, the fraction of positions where the bits agree
+func SokalMichener(x Bits, y Bits) float64 {
+	result, err := TrySokalMichener(x, y)
+	if err != nil {
+		panic(err)
+	}
+	return result
+}
+
+// Computes the Sokal-Michener similarity between two binary vectors, returning an error on invalid input
+func TrySokalMichener(x Bits, y Bits) (float64, error) {
+	c, err := TryBinaryContingency(x, y)
+	if err != nil {
+		return 0, err
+	}
+	return float64(c.A+c.D) / float64(x.Len), nil
+}
+
+// Computes the Rogers-Tanimoto similarity between two binary vectors
+// RogersTanimoto(x,y) = (a + d) / (a + d + 2(b + c)), which weighs disagreements twice as much as agreements
+func RogersTanimoto(x Bits, y Bits) float64 {
+	result, err := TryRogersTanimoto(x, y)
+	if err != nil {
+		panic(err)
+	}
+	return result
+}
+
+// Computes the Rogers-Tanimoto similarity between two binary vectors, returning an error on invalid input
+func TryRogersTanimoto(x Bits, y Bits) (float64, error) {
+	c, err := TryBinaryContingency(x, y)
+	if err != nil {
+		return 0, err
+	}
+	return float64(c.A+c.D) / float64(c.A+c.D+2*(c.B+c.C)), nil
+}
+
+// Computes Yule's Q between two binary vectors, an association measure ranging between -1 and 1
+// Yule(x,y) = (ad - bc) / (ad + bc)
+func Yule(x Bits, y Bits) float64 {
+	result, err := TryYule(x, y)
+	if err != nil {
+		panic(err)
+	}
+	return result
+}
+
+// Computes Yule's Q between two binary vectors, returning an error on invalid input
+// ErrZeroVariance is returned if ad + bc = 0, e.g. when either vector is constant, since Q is undefined
+func TryYule(x Bits, y Bits) (float64, error) {
+	c, err := TryBinaryContingency(x, y)
+	if err != nil {
+		return 0, err
+	}
+	ad, bc := float64(c.A)*float64(c.D), float64(c.B)*float64(c.C)
+	if ad+bc == 0 {
+		return 0, ErrZeroVariance
+	}
+	return (ad - bc) / (ad + bc), nil
+}
+
+// Computes the Kulczynski similarity between two binary vectors
+// Kulczynski(x,y) = (a / (a + b) + a / (a + c)) / 2, the mean fraction of each vector's set bits shared with the other
+func Kulczynski(x Bits, y Bits) float64 {
+	result, err := TryKulczynski(x, y)
+	if err != nil {
+		panic(err)
+	}
+	return result
+}
+
+// Computes the Kulczynski similarity between two binary vectors, returning an error on invalid input
+// ErrZeroMagnitude is returned if either vector has no set bit
+func TryKulczynski(x Bits, y Bits) (float64, error) {
+	c, err := TryBinaryContingency(x, y)
+	if err != nil {
+		return 0, err
+	}
+	if c.A+c.B == 0 || c.A+c.C == 0 {
+		return 0, ErrZeroMagnitude
+	}
+	return (float64(c.A)/float64(c.A+c.B) + float64(c.A)/float64(c.A+c.C)) / 2, nil
+}
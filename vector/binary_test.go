@@ -0,0 +1,96 @@
+package vector
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func TestBits(t *testing.T) {
+	b := BitsFromVector(Vector{1, 0, 0.5, 0, -2})
+	if expected := []bool{true, false, true, false, true}; b.Len != len(expected) {
+		t.Error("Test Failed,", len(expected), " expected,", b.Len, " received.")
+	} else {
+		for i, value := range expected {
+			if b.Get(i) != value {
+				t.Error("Test Failed,", value, " expected,", b.Get(i), " received.")
+			}
+		}
+	}
+	b.Set(1, true)
+	b.Set(0, false)
+	if output := b.Count(); output != 3 {
+		t.Error("Test Failed,", 3, " expected,", output, " received.")
+	}
+	if output, expected := b.ToVector(), (Vector{0, 1, 1, 0, 1}); Hamming(output, expected) != 0 {
+		t.Error("Test Failed,", expected, " expected,", output, " received.")
+	}
+}
+
+func TestBinaryHamming(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	// Lengths around word boundaries
+	for _, n := range []int{1, 63, 64, 65, 130, 1000} {
+		x, y := make([]bool, n), make([]bool, n)
+		expected := 0
+		for i := range x {
+			x[i], y[i] = rng.Intn(2) == 1, rng.Intn(2) == 1
+			if x[i] != y[i] {
+				expected++
+			}
+		}
+		if output := BinaryHamming(BitsFromBools(x), BitsFromBools(y)); output != expected {
+			t.Error("Test Failed,", expected, " expected,", output, " received.")
+		}
+	}
+}
+
+func TestBinaryCoefficients(t *testing.T) {
+	// a = 2, b = 1, c = 2, d = 3
+	x := BitsFromBools([]bool{true, true, true, false, false, false, false, false})
+	y := BitsFromBools([]bool{true, true, false, true, true, false, false, false})
+	if output, expected := BinaryContingency(x, y), (Contingency{A: 2, B: 1, C: 2, D: 3}); output != expected {
+		t.Error("Test Failed,", expected, " expected,", output, " received.")
+	}
+
+	var tests = []struct {
+		name     string
+		metric   func(x Bits, y Bits) float64
+		expected float64
+	}{
+		{"Jaccard", BinaryJaccard, 2.0 / 5},
+		{"Dice", BinaryDice, 4.0 / 7},
+		{"RussellRao", RussellRao, 2.0 / 8},
+		{"SokalMichener", SokalMichener, 5.0 / 8},
+		{"RogersTanimoto", RogersTanimoto, 5.0 / 11},
+		{"Yule", Yule, (6.0 - 2) / (6 + 2)},
+		{"Kulczynski", Kulczynski, (2.0/3 + 2.0/4) / 2},
+	}
+	for _, test := range tests {
+		if output := test.metric(x, y); math.Abs(output-test.expected) > floatDifferenceThresh {
+			t.Error("Test Failed,", test.name, test.expected, " expected,", output, " received.")
+		}
+	}
+}
+
+func TestBinaryErrors(t *testing.T) {
+	empty := NewBits(4)
+	if output := BinaryJaccard(empty, empty); output != 0 {
+		t.Error("Test Failed,", 0, " expected,", output, " received.")
+	}
+	if output := BinaryDice(empty, empty); output != 0 {
+		t.Error("Test Failed,", 0, " expected,", output, " received.")
+	}
+	if _, err := TryKulczynski(empty, BitsFromBools([]bool{true, false, false, false})); err != ErrZeroMagnitude {
+		t.Error("Test Failed,", ErrZeroMagnitude, " expected,", err, " received.")
+	}
+	if _, err := TryYule(empty, empty); err != ErrZeroVariance {
+		t.Error("Test Failed,", ErrZeroVariance, " expected,", err, " received.")
+	}
+	if _, err := TryBinaryHamming(NewBits(3), NewBits(4)); err != ErrDimensionMismatch {
+		t.Error("Test Failed,", ErrDimensionMismatch, " expected,", err, " received.")
+	}
+	if _, err := TryBinaryDice(NewBits(0), NewBits(0)); err != ErrEmptyVector {
+		t.Error("Test Failed,", ErrEmptyVector, " expected,", err, " received.")
+	}
+}
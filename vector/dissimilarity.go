@@ -0,0 +1,166 @@
+package vector
+
+import (
+	"math"
+)
+
+// Computes the Canberra distance between two vectors
+// Canberra(a,b) = sum(|a_i - b_i| / (|a_i| + |b_i|))
+// Dimensions where both a_i and b_i are zero contribute 0 to the sum
+// Reference: https://en.wikipedia.org/wiki/Canberra_distance
+func Canberra(a Vector, b Vector) float64 {
+	result, err := TryCanberra(a, b)
+	if err != nil {
+		panic(err)
+	}
+	return result
+}
+
+// Computes the Canberra distance between two vectors, returning an error on invalid input
+func TryCanberra(a Vector, b Vector) (float64, error) {
+	if err := validate(a, b); err != nil {
+		return 0, err
+	}
+
+	var result float64 = 0
+	for i := 0; i < a.Length(); i++ {
+		den := math.Abs(a[i]) + math.Abs(b[i])
+		if den == 0 {
+			continue
+		}
+		result += math.Abs(a[i]-b[i]) / den
+	}
+	return result, nil
+}
+
+// Computes the Bray-Curtis dissimilarity between two vectors
+// BrayCurtis(a,b) = sum(|a_i - b_i|) / sum(|a_i + b_i|)
+// The dissimilarity ranges between 0 and 1 for non-negative vectors, and is 0 if both vectors are zero
+// Reference: https://en.wikipedia.org/wiki/Bray%E2%80%93Curtis_dissimilarity
+func BrayCurtis(a Vector, b Vector) float64 {
+	result, err := TryBrayCurtis(a, b)
+	if err != nil {
+		panic(err)
+	}
+	return result
+}
+
+// Computes the Bray-Curtis dissimilarity between two vectors, returning an error on invalid input
+func TryBrayCurtis(a Vector, b Vector) (float64, error) {
+	if err := validate(a, b); err != nil {
+		return 0, err
+	}
+
+	var num, den float64
+	for i := 0; i < a.Length(); i++ {
+		num += math.Abs(a[i] - b[i])
+		den += math.Abs(a[i] + b[i])
+	}
+	if den == 0 {
+		return 0, nil
+	}
+	return num / den, nil
+}
+
+// Computes the Chi-square distance between two non-negative vectors, e.g. histograms
+// ChiSquare(a,b) = 1/2 * sum((a_i - b_i)^2 / (a_i + b_i))
+// Dimensions where a_i + b_i is zero contribute 0 to the sum
+func ChiSquare(a Vector, b Vector) float64 {
+	result, err := TryChiSquare(a, b)
+	if err != nil {
+		panic(err)
+	}
+	return result
+}
+
+// Computes the Chi-square distance between two vectors, returning an error on invalid input
+// ErrInvalidParameter is returned if a component is negative
+func TryChiSquare(a Vector, b Vector) (float64, error) {
+	if err := validate(a, b); err != nil {
+		return 0, err
+	}
+
+	var result float64 = 0
+	for i := 0; i < a.Length(); i++ {
+		if a[i] < 0 || b[i] < 0 {
+			return 0, ErrInvalidParameter
+		}
+		den := a[i] + b[i]
+		if den == 0 {
+			continue
+		}
+		d := a[i] - b[i]
+		result += d * d / den
+	}
+	return result / 2, nil
+}
+
+// Computes the standardized Euclidean distance between two vectors
+// StandardizedEuclidean(a,b) = sqrt(sum((a_i - b_i)^2 / v_i)), where v_i is the variance of dimension i
+func StandardizedEuclidean(a Vector, b Vector, variance Vector) float64 {
+	result, err := TryStandardizedEuclidean(a, b, variance)
+	if err != nil {
+		panic(err)
+	}
+	return result
+}
+
+// Computes the standardized Euclidean distance between two vectors, returning an error on invalid input
+// ErrZeroVariance is returned if any variance is zero, and ErrInvalidParameter if any is negative
+func TryStandardizedEuclidean(a Vector, b Vector, variance Vector) (float64, error) {
+	if err := validate(a, variance); err != nil {
+		return 0, err
+	}
+
+	w := make(Vector, variance.Length())
+	for i, value := range variance {
+		if value == 0 {
+			return 0, ErrZeroVariance
+		}
+		if value < 0 {
+			return 0, ErrInvalidParameter
+		}
+		w[i] = 1 / value
+	}
+	return TryWeightedMinkowski(a, b, w, 2)
+}
+
+// Computes the correlation distance between two vectors, i.e. 1 - PearsonCorrelation(a,b)
+// The distance ranges between 0 and 2
+func CorrelationDistance(a Vector, b Vector) float64 {
+	result, err := TryCorrelationDistance(a, b)
+	if err != nil {
+		panic(err)
+	}
+	return result
+}
+
+// Computes the correlation distance between two vectors, returning an error on invalid input
+func TryCorrelationDistance(a Vector, b Vector) (float64, error) {
+	correlation, err := TryPearsonCorrelation(a, b)
+	if err != nil {
+		return 0, err
+	}
+	return 1 - correlation, nil
+}
+
+// Computes the angular distance between two vectors, i.e. arccos(CosineSimilarity(a,b)) / pi
+// Unlike CosineDissimilarity, the angular distance is a true metric. It ranges between 0 and 1
+func AngularDistance(a Vector, b Vector) float64 {
+	result, err := TryAngularDistance(a, b)
+	if err != nil {
+		panic(err)
+	}
+	return result
+}
+
+// Computes the angular distance between two vectors, returning an error on invalid input
+func TryAngularDistance(a Vector, b Vector) (float64, error) {
+	similarity, err := TryCosineSimilarity(a, b)
+	if err != nil {
+		return 0, err
+	}
+	// Rounding can push the cosine slightly outside [-1, 1]
+	similarity = math.Max(-1, math.Min(1, similarity))
+	return math.Acos(similarity) / math.Pi, nil
+}
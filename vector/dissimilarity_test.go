@@ -0,0 +1,117 @@
+package vector
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestCanberra(t *testing.T) {
+	var tests = []struct {
+		v1       Vector
+		v2       Vector
+		expected float64
+	}{
+		{Vector{1, 2, 3}, Vector{1, 2, 3}, 0},
+		{Vector{1, 0, 0}, Vector{0, 1, 0}, 2},
+		{Vector{1, 2, -3}, Vector{3, 2, 1}, 1.5},
+	}
+
+	for _, test := range tests {
+		if output := Canberra(test.v1, test.v2); math.Abs(output-test.expected) > floatDifferenceThresh {
+			t.Error("Test Failed,", test.expected, " expected,", output, " received.")
+		}
+	}
+}
+
+func TestBrayCurtis(t *testing.T) {
+	var tests = []struct {
+		v1       Vector
+		v2       Vector
+		expected float64
+	}{
+		{Vector{6, 7, 4}, Vector{10, 0, 6}, 13.0 / 33},
+		{Vector{1, 0, 0}, Vector{0, 1, 0}, 1},
+		{Vector{0, 0}, Vector{0, 0}, 0},
+	}
+
+	for _, test := range tests {
+		if output := BrayCurtis(test.v1, test.v2); math.Abs(output-test.expected) > floatDifferenceThresh {
+			t.Error("Test Failed,", test.expected, " expected,", output, " received.")
+		}
+	}
+}
+
+func TestChiSquare(t *testing.T) {
+	var tests = []struct {
+		v1       Vector
+		v2       Vector
+		expected float64
+	}{
+		{Vector{1, 2, 3}, Vector{1, 2, 3}, 0},
+		{Vector{2, 0, 4}, Vector{0, 0, 2}, 1 + 1.0/3},
+	}
+
+	for _, test := range tests {
+		if output := ChiSquare(test.v1, test.v2); math.Abs(output-test.expected) > floatDifferenceThresh {
+			t.Error("Test Failed,", test.expected, " expected,", output, " received.")
+		}
+	}
+}
+
+func TestChiSquareNegative(t *testing.T) {
+	if _, err := TryChiSquare(Vector{1, -0.9}, Vector{1, 1}); err != ErrInvalidParameter {
+		t.Error("Test Failed,", ErrInvalidParameter, " expected,", err, " received.")
+	}
+}
+
+func TestStandardizedEuclidean(t *testing.T) {
+	if output := StandardizedEuclidean(Vector{0, 0}, Vector{2, 3}, Vector{4, 9}); math.Abs(output-math.Sqrt(2)) > floatDifferenceThresh {
+		t.Error("Test Failed,", math.Sqrt(2), " expected,", output, " received.")
+	}
+	if _, err := TryStandardizedEuclidean(Vector{0, 0}, Vector{2, 3}, Vector{4, 0}); !errors.Is(err, ErrZeroVariance) {
+		t.Error("Test Failed,", ErrZeroVariance, " expected,", err, " received.")
+	}
+	if _, err := TryStandardizedEuclidean(Vector{0, 0}, Vector{2, 3}, Vector{4}); !errors.Is(err, ErrDimensionMismatch) {
+		t.Error("Test Failed,", ErrDimensionMismatch, " expected,", err, " received.")
+	}
+}
+
+func TestCorrelationDistance(t *testing.T) {
+	var tests = []struct {
+		v1       Vector
+		v2       Vector
+		expected float64
+	}{
+		{Vector{1, 2, 3, 4, 5}, Vector{1, 2, 3, 4, 5}, 0},
+		{Vector{1, 2, 3, 4, 5}, Vector{5, 4, 3, 2, 1}, 2},
+		{Vector{43, 21, 25, 42, 57, 59}, Vector{99, 65, 79, 75, 87, 81}, 1 - 0.5298},
+	}
+
+	for _, test := range tests {
+		if output := CorrelationDistance(test.v1, test.v2); math.Abs(output-test.expected) > floatDifferenceThresh {
+			t.Error("Test Failed,", test.expected, " expected,", output, " received.")
+		}
+	}
+}
+
+func TestAngularDistance(t *testing.T) {
+	var tests = []struct {
+		v1       Vector
+		v2       Vector
+		expected float64
+	}{
+		{Vector{1, 0}, Vector{0, 1}, 0.5},
+		{Vector{1, 0}, Vector{-1, 0}, 1},
+		{Vector{1, 2, 3}, Vector{2, 4, 6}, 0},
+	}
+
+	for _, test := range tests {
+		if output := AngularDistance(test.v1, test.v2); math.Abs(output-test.expected) > floatDifferenceThresh {
+			t.Error("Test Failed,", test.expected, " expected,", output, " received.")
+		}
+	}
+	if _, err := TryAngularDistance(Vector{0, 0}, Vector{1, 1}); !errors.Is(err, ErrZeroMagnitude) {
+		t.Error("Test Failed,", ErrZeroMagnitude, " expected,", err, " received.")
+	}
+}
@@ -1,28 +1,18 @@
 package vector
 
-import (
-	"math"
-)
-
-type Vector []float64
+// Vector is a float64 vector, the default element type used throughout this package
+type Vector = Vec[float64]
 
 // Computes the Minkowski distance between two vectors
 // Minkowski distance is the generalized metric form of Manhattan and Euclidean distance
 func Minkowski(a Vector, b Vector, p int) float64 {
+	return MinkowskiOf(a, b, p)
+}
 
-	if a.Length() == 0 || b.Length() == 0 {
-		panic("Vectors a and b cannot be empty")
-	}
-
-	if a.Length() != b.Length() {
-		panic("Vectors a and b must be of the same length.")
-	}
-
-	var result float64 = 0
-	for i := 0; i < a.Length(); i++ {
-		result += math.Pow(math.Abs(a.At(i)-b.At(i)), float64(p))
-	}
-	return math.Pow(result, 1/float64(p))
+// Computes the Minkowski distance between two vectors, returning an error on invalid input
+// ErrInvalidParameter is returned if p is not positive
+func TryMinkowski(a Vector, b Vector, p int) (float64, error) {
+	return TryMinkowskiOf(a, b, p)
 }
 
 // Computes the Manhattan distance between two vectors
@@ -38,76 +28,53 @@ func Euclidean(a Vector, b Vector) float64 {
 
 // Computes the Chebyshev distance between two vectors
 func Chebyshev(a Vector, b Vector) float64 {
-	var result float64 = 0
-	if a.Length() == 0 || b.Length() == 0 {
-		panic("Vectors a and b cannot be empty")
-	}
-
-	if a.Length() != b.Length() {
-		panic("Vectors a and b must be of the same length.")
-	}
+	return ChebyshevOf(a, b)
+}
 
-	for i := 0; i < a.Length(); i++ {
-		d := math.Abs(a[i] - b[i])
-		if d > result {
-			result = d
-		}
-	}
-	return result
+// Computes the Chebyshev distance between two vectors, returning an error on invalid input
+func TryChebyshev(a Vector, b Vector) (float64, error) {
+	return TryChebyshevOf(a, b)
 }
 
 // Computes the Squared euclidean distance between two vectors
 func SquaredEuclidean(a Vector, b Vector) float64 {
-	return math.Pow(Euclidean(a, b), 2)
+	return SquaredEuclideanOf(a, b)
 }
 
+// Computes the sample covariance between two vectors
 func Covariance(a Vector, b Vector) float64 {
-	if a.Length() == 0 || b.Length() == 0 {
-		panic("Vectors a and b cannot be empty")
-	}
-
-	if a.Length() != b.Length() {
-		panic("Vectors a and b must be of the same length.")
-	}
-
-	var result float64 = 0
-	for i := 0; i < a.Length(); i++ {
-		result += (a[i] - a.Mean()) * (b[i] - b.Mean())
-	}
+	return CovarianceOf(a, b)
+}
 
-	return result / float64(a.Length()-1)
+// Computes the sample covariance between two vectors, returning an error on invalid input
+func TryCovariance(a Vector, b Vector) (float64, error) {
+	return TryCovarianceOf(a, b)
 }
 
 // Computes the Pearson Correlation between two vectors
 // The returned correlation varies between -1 and 1
 // 1 indicates a strong positive correlation between the vectors, and -1 indicates a strong negative correlation, 0 indicates no relationship
 func PearsonCorrelation(a Vector, b Vector) float64 {
-	var correlation float64 = 0
-	if a.Length() == 0 || b.Length() == 0 {
-		panic("Vectors a and b cannot be empty")
-	}
-
-	if a.Length() != b.Length() {
-		panic("Vectors a and b must be of the same length.")
-	}
+	return PearsonCorrelationOf(a, b)
+}
 
-	correlation = Covariance(a, b) / (a.Stdev() * b.Stdev())
-	return correlation
+// Computes the Pearson Correlation between two vectors, returning an error on invalid input
+// ErrZeroVariance is returned if either vector is constant, since the correlation is undefined
+func TryPearsonCorrelation(a Vector, b Vector) (float64, error) {
+	return TryPearsonCorrelationOf(a, b)
 }
 
 // Computes the Cosing similarity distance between two non-zero vectors
 // Cosine similarity basically measures the similarity between two vectors based on the cosine of the angle between them
 // Useful for finding similarity between documents in Natural language processing
 func CosineSimilarity(a Vector, b Vector) float64 {
-	if a.Length() == 0 || b.Length() == 0 {
-		panic("Vectors a and b cannot be empty")
-	}
-
-	if a.Length() != b.Length() {
-		panic("Vectors a and b must be of the same length.")
-	}
+	return CosineSimilarityOf(a, b)
+}
 
-	return a.Dot(b) / (a.Magnitude() * b.Magnitude())
+// Computes the Cosine similarity between two vectors, returning an error on invalid input
+// ErrZeroMagnitude is returned if either vector is the zero vector, since the angle is undefined
+func TryCosineSimilarity(a Vector, b Vector) (float64, error) {
+	return TryCosineSimilarityOf(a, b)
 }
 
 // Computes the Cosine dissimilarity between two vectors
@@ -119,57 +86,36 @@ func CosineDissimilarity(a Vector, b Vector) float64 {
 // Hamming distance basically measures the number of bit positions in the two arrays where the corresponding symbols are different
 // Useful for error detection and error correction in data transmitted over computer networks
 func Hamming(a Vector, b Vector) int {
-	if a.Length() == 0 || b.Length() == 0 {
-		panic("Vectors a and b cannot be empty")
-	}
-
-	if a.Length() != b.Length() {
-		panic("Vectors a and b must be of the same length.")
-	}
+	return HammingOf(a, b)
+}
 
-	count := 0
-	for i := 0; i < a.Length(); i++ {
-		if a[i] != b[i] {
-			count++
-		}
-	}
-	return count
+// Computes the Hamming distance between two vectors, returning an error on invalid input
+func TryHamming(a Vector, b Vector) (int, error) {
+	return TryHammingOf(a, b)
 }
 
 /* Utility functions */
 
 // Add two vectors
 func Add(a Vector, b Vector) Vector {
-	if a.Length() == 0 || b.Length() == 0 {
-		return Vector{}
-	}
-
-	if a.Length() != b.Length() {
-		panic("Vectors a and b must be of the same length.")
-	}
+	return AddOf(a, b)
+}
 
-	sum := make(Vector, a.Length())
-	for i := 0; i < a.Length(); i++ {
-		sum[i] = a[i] + b[i]
-	}
-	return sum
+// Add two vectors, returning an error on invalid input
+// An empty vector is returned if either of the vectors is empty
+func TryAdd(a Vector, b Vector) (Vector, error) {
+	return TryAddOf(a, b)
 }
 
 // Subtract two vectors
 func Subtract(a Vector, b Vector) Vector {
-	if a.Length() == 0 || b.Length() == 0 {
-		return Vector{}
-	}
-
-	if a.Length() != b.Length() {
-		panic("Vectors a and b must be of the same length.")
-	}
+	return SubtractOf(a, b)
+}
 
-	diff := make(Vector, a.Length())
-	for i := 0; i < a.Length(); i++ {
-		diff[i] = a[i] - b[i]
-	}
-	return diff
+// Subtract two vectors, returning an error on invalid input
+// An empty vector is returned if either of the vectors is empty
+func TrySubtract(a Vector, b Vector) (Vector, error) {
+	return TrySubtractOf(a, b)
 }
 
 // Map an anonymous function to the vector
@@ -180,90 +126,3 @@ func Map(a Vector, f func(a interface{}) interface{}) Vector {
 	}
 	return result
 }
-
-// Computes the length (n) of an n-dimensional vector
-func (v Vector) Length() int {
-	return len(v)
-}
-
-// Computes the element at a particular index in an n-dimensional vector
-func (v Vector) At(index int) float64 {
-	if index > v.Length()-1 {
-		panic("Vector index out of range.")
-	}
-	return v[index]
-}
-
-// Computes the mean of an n-dimensional vector
-func (v Vector) Mean() float64 {
-	var sum float64 = 0
-	for _, value := range v {
-		sum += value
-	}
-	return sum / float64(v.Length())
-}
-
-// Computes the Standard deviation of elements in a vector
-func (v Vector) Stdev() float64 {
-	var result float64 = 0
-	for _, value := range v {
-		result += math.Pow(value-v.Mean(), 2)
-	}
-	return math.Sqrt(result / float64(v.Length()-1))
-}
-
-// Computes the magnitude of an n-dimensional vector
-func (v Vector) Magnitude() float64 {
-	var result float64 = 0
-	for _, value := range v {
-		result += math.Pow(value, 2)
-	}
-	return math.Sqrt(result)
-}
-
-// Computes the Dot product between a vector and another vector of the same length
-func (v Vector) Dot(u Vector) float64 {
-	var result float64 = 0
-	if v.Length() != u.Length() {
-		panic("The length of the vectors must be equal.")
-	}
-
-	for i := 0; i < v.Length(); i++ {
-		result += v[i] * u[i]
-	}
-	return result
-}
-
-// Computes the index of the maximum element in a a vector
-func (v Vector) Maxarg() int {
-	maxIdx := 0
-	for i := 0; i < v.Length(); i++ {
-		if v[i] > v[maxIdx] {
-			maxIdx = i
-		}
-	}
-	return maxIdx
-}
-
-// Computes the index of the minimum element in a vector
-func (v Vector) Minarg() int {
-	minIdx := 0
-	for i := 0; i < v.Length(); i++ {
-		if v[i] < v[minIdx] {
-			minIdx = i
-		}
-	}
-	return minIdx
-}
-
-// Computes the maximum element in a vector
-func (v Vector) Max() float64 {
-	maxIdx := v.Maxarg()
-	return v[maxIdx]
-}
-
-// Computes the minimum element in a vector
-func (v Vector) Min() float64 {
-	minIdx := v.Minarg()
-	return v[minIdx]
-}
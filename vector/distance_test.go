@@ -1,6 +1,7 @@
 package vector
 
 import (
+	"errors"
 	"math"
 	"reflect"
 	"testing"
@@ -249,3 +250,53 @@ func TestPearsonCorrelation(t *testing.T) {
 		}
 	}
 }
+
+func TestTryErrors(t *testing.T) {
+	nan := math.NaN()
+	var tests = []struct {
+		name     string
+		f        func() error
+		expected error
+	}{
+		{"Minkowski empty", func() error { _, err := TryMinkowski(Vector{}, Vector{1}, 2); return err }, ErrEmptyVector},
+		{"Minkowski mismatch", func() error { _, err := TryMinkowski(Vector{1, 2}, Vector{1}, 2); return err }, ErrDimensionMismatch},
+		{"Minkowski NaN", func() error { _, err := TryMinkowski(Vector{1, nan}, Vector{1, 2}, 2); return err }, ErrNaN},
+		{"Minkowski p = 0", func() error { _, err := TryMinkowski(Vector{1, 2}, Vector{1, 3}, 0); return err }, ErrInvalidParameter},
+		{"Minkowski p < 0", func() error { _, err := TryMinkowski(Vector{1, 2}, Vector{1, 3}, -1); return err }, ErrInvalidParameter},
+		{"Minkowski ok", func() error { _, err := TryMinkowski(Vector{1, 2}, Vector{1, 2}, 2); return err }, nil},
+		{"Chebyshev mismatch", func() error { _, err := TryChebyshev(Vector{1, 2}, Vector{1}); return err }, ErrDimensionMismatch},
+		{"Covariance empty", func() error { _, err := TryCovariance(Vector{}, Vector{}); return err }, ErrEmptyVector},
+		{"Pearson zero variance", func() error { _, err := TryPearsonCorrelation(Vector{1, 1, 1}, Vector{1, 2, 3}); return err }, ErrZeroVariance},
+		{"Cosine zero magnitude", func() error { _, err := TryCosineSimilarity(Vector{0, 0}, Vector{1, 2}); return err }, ErrZeroMagnitude},
+		{"Hamming NaN", func() error { _, err := TryHamming(Vector{0, 1}, Vector{nan, 1}); return err }, ErrNaN},
+		{"Add mismatch", func() error { _, err := TryAdd(Vector{1, 2}, Vector{1}); return err }, ErrDimensionMismatch},
+		{"Add empty", func() error { _, err := TryAdd(Vector{}, Vector{}); return err }, nil},
+		{"Subtract mismatch", func() error { _, err := TrySubtract(Vector{1}, Vector{1, 2}); return err }, ErrDimensionMismatch},
+		{"At out of range", func() error { _, err := Vector{1, 2}.TryAt(2); return err }, ErrIndexOutOfRange},
+		{"At negative", func() error { _, err := Vector{1, 2}.TryAt(-1); return err }, ErrIndexOutOfRange},
+	}
+
+	for _, test := range tests {
+		if output := test.f(); !errors.Is(output, test.expected) {
+			t.Error("Test Failed,", test.name, ":", test.expected, " expected,", output, " received.")
+		}
+	}
+}
+
+func TestPanicsWithSentinel(t *testing.T) {
+	defer func() {
+		if r := recover(); r != ErrDimensionMismatch {
+			t.Error("Test Failed,", ErrDimensionMismatch, " expected,", r, " received.")
+		}
+	}()
+	Euclidean(Vector{1, 2}, Vector{1})
+}
+
+func TestDotPanicsWithSentinel(t *testing.T) {
+	defer func() {
+		if r := recover(); r != ErrDimensionMismatch {
+			t.Error("Test Failed,", ErrDimensionMismatch, " expected,", r, " received.")
+		}
+	}()
+	Vector{1, 2}.Dot(Vector{1})
+}
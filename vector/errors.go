@@ -0,0 +1,35 @@
+package vector
+
+import "errors"
+
+// Sentinel errors returned by the error-returning (Try*) forms of the vector functions
+// Callers can match against them with errors.Is
+var (
+	ErrEmptyVector       = errors.New("vector: vectors cannot be empty")
+	ErrDimensionMismatch = errors.New("vector: vectors must be of the same length")
+	ErrZeroMagnitude     = errors.New("vector: vector has zero magnitude")
+	ErrZeroVariance      = errors.New("vector: vector has zero variance")
+	ErrNaN               = errors.New("vector: vector contains NaN")
+	ErrIndexOutOfRange   = errors.New("vector: index out of range")
+	ErrInsufficientData  = errors.New("vector: not enough observations")
+	ErrSingularMatrix    = errors.New("vector: matrix is singular")
+	ErrInvalidParameter  = errors.New("vector: invalid parameter")
+	ErrNegativeWeight    = errors.New("vector: weights cannot be negative")
+)
+
+// Validates a pair of vectors before computing a metric on them
+// The vectors must be non-empty, of the same length and must not contain NaN values
+func validate[T Number](a Vec[T], b Vec[T]) error {
+	if a.Length() == 0 || b.Length() == 0 {
+		return ErrEmptyVector
+	}
+
+	if a.Length() != b.Length() {
+		return ErrDimensionMismatch
+	}
+
+	if a.hasNaN() || b.hasNaN() {
+		return ErrNaN
+	}
+	return nil
+}
@@ -0,0 +1,342 @@
+package vector
+
+import (
+	"math"
+)
+
+// Number is the set of element types supported by the generic vector functions
+type Number interface {
+	~float32 | ~float64 |
+		~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64
+}
+
+// Vec is an n-dimensional vector over any Number element type
+// Metrics and statistics over a Vec are accumulated in float64 regardless of the element type
+type Vec[T Number] []T
+
+// Computes the Minkowski distance between two generic vectors
+func MinkowskiOf[T Number](a Vec[T], b Vec[T], p int) float64 {
+	result, err := TryMinkowskiOf(a, b, p)
+	if err != nil {
+		panic(err)
+	}
+	return result
+}
+
+// Computes the Minkowski distance between two generic vectors, returning an error on invalid input
+// ErrInvalidParameter is returned if p is not positive
+func TryMinkowskiOf[T Number](a Vec[T], b Vec[T], p int) (float64, error) {
+	if err := validate(a, b); err != nil {
+		return 0, err
+	}
+	if p <= 0 {
+		return 0, ErrInvalidParameter
+	}
+
+	var result float64 = 0
+	for i := 0; i < a.Length(); i++ {
+		result += math.Pow(math.Abs(float64(a[i])-float64(b[i])), float64(p))
+	}
+	return math.Pow(result, 1/float64(p)), nil
+}
+
+// Computes the Manhattan distance between two generic vectors
+func ManhattanOf[T Number](a Vec[T], b Vec[T]) float64 {
+	return MinkowskiOf(a, b, 1)
+}
+
+// Computes the Euclidean distance between two generic vectors
+func EuclideanOf[T Number](a Vec[T], b Vec[T]) float64 {
+	return MinkowskiOf(a, b, 2)
+}
+
+// Computes the Squared euclidean distance between two generic vectors
+func SquaredEuclideanOf[T Number](a Vec[T], b Vec[T]) float64 {
+	d := EuclideanOf(a, b)
+	return d * d
+}
+
+// Computes the Chebyshev distance between two generic vectors
+func ChebyshevOf[T Number](a Vec[T], b Vec[T]) float64 {
+	result, err := TryChebyshevOf(a, b)
+	if err != nil {
+		panic(err)
+	}
+	return result
+}
+
+// Computes the Chebyshev distance between two generic vectors, returning an error on invalid input
+func TryChebyshevOf[T Number](a Vec[T], b Vec[T]) (float64, error) {
+	if err := validate(a, b); err != nil {
+		return 0, err
+	}
+
+	var result float64 = 0
+	for i := 0; i < a.Length(); i++ {
+		d := math.Abs(float64(a[i]) - float64(b[i]))
+		if d > result {
+			result = d
+		}
+	}
+	return result, nil
+}
+
+// Computes the sample covariance between two generic vectors
+func CovarianceOf[T Number](a Vec[T], b Vec[T]) float64 {
+	result, err := TryCovarianceOf(a, b)
+	if err != nil {
+		panic(err)
+	}
+	return result
+}
+
+// Computes the sample covariance between two generic vectors, returning an error on invalid input
+func TryCovarianceOf[T Number](a Vec[T], b Vec[T]) (float64, error) {
+	if err := validate(a, b); err != nil {
+		return 0, err
+	}
+
+	return PairStatsOf(a, b).Covariance(), nil
+}
+
+// Computes the Pearson Correlation between two generic vectors
+func PearsonCorrelationOf[T Number](a Vec[T], b Vec[T]) float64 {
+	correlation, err := TryPearsonCorrelationOf(a, b)
+	if err != nil {
+		panic(err)
+	}
+	return correlation
+}
+
+// Computes the Pearson Correlation between two generic vectors, returning an error on invalid input
+// ErrZeroVariance is returned if either vector is constant, since the correlation is undefined
+func TryPearsonCorrelationOf[T Number](a Vec[T], b Vec[T]) (float64, error) {
+	if err := validate(a, b); err != nil {
+		return 0, err
+	}
+
+	stats := PairStatsOf(a, b)
+	if stats.X.m2 == 0 || stats.Y.m2 == 0 {
+		return 0, ErrZeroVariance
+	}
+	return stats.Correlation(), nil
+}
+
+// Computes the Cosine similarity between two generic vectors
+func CosineSimilarityOf[T Number](a Vec[T], b Vec[T]) float64 {
+	result, err := TryCosineSimilarityOf(a, b)
+	if err != nil {
+		panic(err)
+	}
+	return result
+}
+
+// Computes the Cosine similarity between two generic vectors, returning an error on invalid input
+// ErrZeroMagnitude is returned if either vector is the zero vector, since the angle is undefined
+func TryCosineSimilarityOf[T Number](a Vec[T], b Vec[T]) (float64, error) {
+	if err := validate(a, b); err != nil {
+		return 0, err
+	}
+
+	ma, mb := a.Magnitude(), b.Magnitude()
+	if ma == 0 || mb == 0 {
+		return 0, ErrZeroMagnitude
+	}
+	return a.Dot(b) / (ma * mb), nil
+}
+
+// Computes the Cosine dissimilarity between two generic vectors
+func CosineDissimilarityOf[T Number](a Vec[T], b Vec[T]) float64 {
+	return 1 - CosineSimilarityOf(a, b)
+}
+
+// Computes the Hamming distance between two generic vectors
+func HammingOf[T Number](a Vec[T], b Vec[T]) int {
+	count, err := TryHammingOf(a, b)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Computes the Hamming distance between two generic vectors, returning an error on invalid input
+func TryHammingOf[T Number](a Vec[T], b Vec[T]) (int, error) {
+	if err := validate(a, b); err != nil {
+		return 0, err
+	}
+
+	count := 0
+	for i := 0; i < a.Length(); i++ {
+		if a[i] != b[i] {
+			count++
+		}
+	}
+	return count, nil
+}
+
+/* Utility functions */
+
+// Add two generic vectors
+// The addition is carried out in the element type, so integer vectors may overflow
+func AddOf[T Number](a Vec[T], b Vec[T]) Vec[T] {
+	sum, err := TryAddOf(a, b)
+	if err != nil {
+		panic(err)
+	}
+	return sum
+}
+
+// Add two generic vectors, returning an error on invalid input
+// An empty vector is returned if either of the vectors is empty
+func TryAddOf[T Number](a Vec[T], b Vec[T]) (Vec[T], error) {
+	if a.Length() == 0 || b.Length() == 0 {
+		return Vec[T]{}, nil
+	}
+
+	if err := validate(a, b); err != nil {
+		return nil, err
+	}
+
+	sum := make(Vec[T], a.Length())
+	for i := 0; i < a.Length(); i++ {
+		sum[i] = a[i] + b[i]
+	}
+	return sum, nil
+}
+
+// Subtract two generic vectors
+// The subtraction is carried out in the element type, so unsigned vectors may wrap around
+func SubtractOf[T Number](a Vec[T], b Vec[T]) Vec[T] {
+	diff, err := TrySubtractOf(a, b)
+	if err != nil {
+		panic(err)
+	}
+	return diff
+}
+
+// Subtract two generic vectors, returning an error on invalid input
+// An empty vector is returned if either of the vectors is empty
+func TrySubtractOf[T Number](a Vec[T], b Vec[T]) (Vec[T], error) {
+	if a.Length() == 0 || b.Length() == 0 {
+		return Vec[T]{}, nil
+	}
+
+	if err := validate(a, b); err != nil {
+		return nil, err
+	}
+
+	diff := make(Vec[T], a.Length())
+	for i := 0; i < a.Length(); i++ {
+		diff[i] = a[i] - b[i]
+	}
+	return diff, nil
+}
+
+// Converts a generic vector to a float64 Vector
+func ToVector[T Number](v Vec[T]) Vector {
+	result := make(Vector, v.Length())
+	for i, value := range v {
+		result[i] = float64(value)
+	}
+	return result
+}
+
+// Computes the length (n) of an n-dimensional vector
+func (v Vec[T]) Length() int {
+	return len(v)
+}
+
+// Computes the element at a particular index in an n-dimensional vector
+func (v Vec[T]) At(index int) T {
+	value, err := v.TryAt(index)
+	if err != nil {
+		panic(err)
+	}
+	return value
+}
+
+// Computes the element at a particular index in an n-dimensional vector, returning an error if the index is out of range
+func (v Vec[T]) TryAt(index int) (T, error) {
+	if index < 0 || index > v.Length()-1 {
+		return 0, ErrIndexOutOfRange
+	}
+	return v[index], nil
+}
+
+// Computes the mean of an n-dimensional vector
+func (v Vec[T]) Mean() float64 {
+	return v.Stats().Mean()
+}
+
+// Computes the Standard deviation of elements in a vector
+func (v Vec[T]) Stdev() float64 {
+	return v.Stats().Stdev()
+}
+
+// Computes the magnitude of an n-dimensional vector
+func (v Vec[T]) Magnitude() float64 {
+	var result float64 = 0
+	for _, value := range v {
+		x := float64(value)
+		result += x * x
+	}
+	return math.Sqrt(result)
+}
+
+// Computes the Dot product between a vector and another vector of the same length
+func (v Vec[T]) Dot(u Vec[T]) float64 {
+	var result float64 = 0
+	if v.Length() != u.Length() {
+		panic(ErrDimensionMismatch)
+	}
+
+	for i := 0; i < v.Length(); i++ {
+		result += float64(v[i]) * float64(u[i])
+	}
+	return result
+}
+
+// Computes the index of the maximum element in a a vector
+func (v Vec[T]) Maxarg() int {
+	maxIdx := 0
+	for i := 0; i < v.Length(); i++ {
+		if v[i] > v[maxIdx] {
+			maxIdx = i
+		}
+	}
+	return maxIdx
+}
+
+// Computes the index of the minimum element in a vector
+func (v Vec[T]) Minarg() int {
+	minIdx := 0
+	for i := 0; i < v.Length(); i++ {
+		if v[i] < v[minIdx] {
+			minIdx = i
+		}
+	}
+	return minIdx
+}
+
+// Computes the maximum element in a vector
+func (v Vec[T]) Max() T {
+	maxIdx := v.Maxarg()
+	return v[maxIdx]
+}
+
+// Computes the minimum element in a vector
+func (v Vec[T]) Min() T {
+	minIdx := v.Minarg()
+	return v[minIdx]
+}
+
+// Reports whether the vector contains a NaN value
+func (v Vec[T]) hasNaN() bool {
+	for _, value := range v {
+		if value != value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,83 @@
+package vector
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestEuclideanOfFloat32(t *testing.T) {
+	var tests = []struct {
+		v1       Vec[float32]
+		v2       Vec[float32]
+		expected float64
+	}{
+		{Vec[float32]{3, 2}, Vec[float32]{4, 1}, math.Sqrt(2)},
+		{Vec[float32]{22, 1, 42, 10}, Vec[float32]{20, 0, 36, 8}, 6.7082},
+	}
+
+	for _, test := range tests {
+		if output := EuclideanOf(test.v1, test.v2); math.Abs(output-test.expected) > floatDifferenceThresh {
+			t.Error("Test Failed,", test.expected, " expected,", output, " received.")
+		}
+	}
+}
+
+func TestManhattanOfUnsigned(t *testing.T) {
+	var tests = []struct {
+		v1       Vec[uint8]
+		v2       Vec[uint8]
+		expected float64
+	}{
+		{Vec[uint8]{2, 4, 4, 6}, Vec[uint8]{5, 5, 7, 8}, 9},
+		{Vec[uint8]{0, 255}, Vec[uint8]{255, 0}, 510},
+	}
+
+	for _, test := range tests {
+		if output := ManhattanOf(test.v1, test.v2); math.Abs(output-test.expected) > floatDifferenceThresh {
+			t.Error("Test Failed,", test.expected, " expected,", output, " received.")
+		}
+	}
+}
+
+func TestCosineSimilarityOfInt(t *testing.T) {
+	var tests = []struct {
+		v1       Vec[int]
+		v2       Vec[int]
+		expected float64
+	}{
+		{Vec[int]{3, 2, 0, 5}, Vec[int]{1, 0, 0, 1}, 8 / (math.Sqrt(38) * math.Sqrt(2))},
+	}
+
+	for _, test := range tests {
+		if output := CosineSimilarityOf(test.v1, test.v2); math.Abs(output-test.expected) > floatDifferenceThresh {
+			t.Error("Test Failed,", test.expected, " expected,", output, " received.")
+		}
+	}
+}
+
+func TestGenericMethods(t *testing.T) {
+	v := Vec[int32]{4, -2, 9, 1}
+	if output := v.Mean(); output != 3 {
+		t.Error("Test Failed,", 3, " expected,", output, " received.")
+	}
+	if output := v.Max(); output != 9 {
+		t.Error("Test Failed,", 9, " expected,", output, " received.")
+	}
+	if output := v.Min(); output != -2 {
+		t.Error("Test Failed,", -2, " expected,", output, " received.")
+	}
+	if output := v.Dot(v); output != 102 {
+		t.Error("Test Failed,", 102, " expected,", output, " received.")
+	}
+	if output := v.Stdev(); math.Abs(output-4.6904) > floatDifferenceThresh {
+		t.Error("Test Failed,", 4.6904, " expected,", output, " received.")
+	}
+}
+
+func TestToVector(t *testing.T) {
+	expected := Vector{1, 2.5, -3}
+	if output := ToVector(Vec[float32]{1, 2.5, -3}); !reflect.DeepEqual(expected, output) {
+		t.Error("Test Failed,", expected, " expected,", output, " received.")
+	}
+}
@@ -0,0 +1,311 @@
+package vector
+
+import (
+	"math"
+)
+
+// Matrix is a dense row-major matrix of float64 values
+type Matrix [][]float64
+
+// Initialize a new rows x cols matrix filled with zeros
+func NewMatrix(rows int, cols int) Matrix {
+	m := make(Matrix, rows)
+	for i := range m {
+		m[i] = make([]float64, cols)
+	}
+	return m
+}
+
+// Initialize a new n x n identity matrix
+func Identity(n int) Matrix {
+	m := NewMatrix(n, n)
+	for i := 0; i < n; i++ {
+		m[i][i] = 1
+	}
+	return m
+}
+
+// Returns the number of rows and columns of the matrix
+func (m Matrix) Dims() (int, int) {
+	if len(m) == 0 {
+		return 0, 0
+	}
+	return len(m), len(m[0])
+}
+
+// Returns a deep copy of the matrix
+func (m Matrix) Clone() Matrix {
+	c := make(Matrix, len(m))
+	for i := range m {
+		c[i] = append([]float64(nil), m[i]...)
+	}
+	return c
+}
+
+// Multiplies the matrix by a vector, i.e. m * v
+func (m Matrix) MulVec(v Vector) Vector {
+	result := make(Vector, len(m))
+	for i := range m {
+		result[i] = Vector(m[i]).Dot(v)
+	}
+	return result
+}
+
+// Reports whether the matrix is square with rows of equal length
+func (m Matrix) isSquare() bool {
+	for _, row := range m {
+		if len(row) != len(m) {
+			return false
+		}
+	}
+	return true
+}
+
+// Computes the sample covariance matrix of a dataset
+// Each vector in data is one observation, and entry (i, j) is the covariance between dimensions i and j
+func CovarianceMatrix(data []Vector) Matrix {
+	m, err := TryCovarianceMatrix(data)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// Computes the sample covariance matrix of a dataset, returning an error on invalid input
+// At least two observations are required, otherwise ErrInsufficientData is returned
+func TryCovarianceMatrix(data []Vector) (Matrix, error) {
+	if len(data) == 0 {
+		return nil, ErrEmptyVector
+	}
+	for _, row := range data {
+		if err := validate(data[0], row); err != nil {
+			return nil, err
+		}
+	}
+	if len(data) < 2 {
+		return nil, ErrInsufficientData
+	}
+
+	n, d := len(data), data[0].Length()
+	mean := make(Vector, d)
+	for _, row := range data {
+		for j, value := range row {
+			mean[j] += value
+		}
+	}
+	for j := range mean {
+		mean[j] /= float64(n)
+	}
+
+	cov := NewMatrix(d, d)
+	for _, row := range data {
+		for i := 0; i < d; i++ {
+			di := row[i] - mean[i]
+			for j := i; j < d; j++ {
+				cov[i][j] += di * (row[j] - mean[j])
+			}
+		}
+	}
+	for i := 0; i < d; i++ {
+		for j := i; j < d; j++ {
+			cov[i][j] /= float64(n - 1)
+			cov[j][i] = cov[i][j]
+		}
+	}
+	return cov, nil
+}
+
+// Computes the inverse of a square matrix using Gauss-Jordan elimination with partial pivoting
+// ErrSingularMatrix is returned if the matrix is not invertible
+func Inverse(m Matrix) (Matrix, error) {
+	if len(m) == 0 {
+		return nil, ErrEmptyVector
+	}
+	if !m.isSquare() {
+		return nil, ErrDimensionMismatch
+	}
+
+	n := len(m)
+	a := m.Clone()
+	inv := Identity(n)
+
+	// Scale used to decide when a pivot is numerically zero
+	var scale float64 = 0
+	for i := range a {
+		for _, value := range a[i] {
+			scale = math.Max(scale, math.Abs(value))
+		}
+	}
+	tol := float64(n) * scale * 1e-12
+
+	for col := 0; col < n; col++ {
+		pivot := col
+		for row := col + 1; row < n; row++ {
+			if math.Abs(a[row][col]) > math.Abs(a[pivot][col]) {
+				pivot = row
+			}
+		}
+		if math.Abs(a[pivot][col]) <= tol {
+			return nil, ErrSingularMatrix
+		}
+		a[col], a[pivot] = a[pivot], a[col]
+		inv[col], inv[pivot] = inv[pivot], inv[col]
+
+		p := a[col][col]
+		for j := 0; j < n; j++ {
+			a[col][j] /= p
+			inv[col][j] /= p
+		}
+
+		for row := 0; row < n; row++ {
+			if row == col || a[row][col] == 0 {
+				continue
+			}
+			f := a[row][col]
+			for j := 0; j < n; j++ {
+				a[row][j] -= f * a[col][j]
+				inv[row][j] -= f * inv[col][j]
+			}
+		}
+	}
+	return inv, nil
+}
+
+// Computes the inverse of (m + lambda*I), i.e. a Tikhonov regularised inverse
+// A small positive lambda makes a singular or ill-conditioned covariance matrix invertible
+func RegularizedInverse(m Matrix, lambda float64) (Matrix, error) {
+	if len(m) == 0 {
+		return nil, ErrEmptyVector
+	}
+	if !m.isSquare() {
+		return nil, ErrDimensionMismatch
+	}
+
+	r := m.Clone()
+	for i := range r {
+		r[i][i] += lambda
+	}
+	return Inverse(r)
+}
+
+// Computes the Moore-Penrose pseudo-inverse of a symmetric matrix such as a covariance matrix
+// The matrix is diagonalised with the cyclic Jacobi eigenvalue algorithm and eigenvalues
+// whose magnitude is below a relative tolerance are treated as zero
+// Reference: https://en.wikipedia.org/wiki/Jacobi_eigenvalue_algorithm
+func PseudoInverse(m Matrix) (Matrix, error) {
+	if len(m) == 0 {
+		return nil, ErrEmptyVector
+	}
+	if !m.isSquare() {
+		return nil, ErrDimensionMismatch
+	}
+
+	n := len(m)
+	values, vectors := symmetricEigen(m)
+
+	var maxValue float64 = 0
+	for _, value := range values {
+		maxValue = math.Max(maxValue, math.Abs(value))
+	}
+	tol := float64(n) * maxValue * 1e-12
+
+	pinv := NewMatrix(n, n)
+	for k, value := range values {
+		if math.Abs(value) <= tol {
+			continue
+		}
+		for i := 0; i < n; i++ {
+			for j := 0; j < n; j++ {
+				pinv[i][j] += vectors[i][k] * vectors[j][k] / value
+			}
+		}
+	}
+	return pinv, nil
+}
+
+// Computes the eigenvalues and eigenvectors (as columns) of a symmetric matrix
+// using cyclic Jacobi rotations
+func symmetricEigen(m Matrix) ([]float64, Matrix) {
+	n := len(m)
+	a := m.Clone()
+	v := Identity(n)
+
+	for sweep := 0; sweep < 100; sweep++ {
+		var off float64 = 0
+		for i := 0; i < n; i++ {
+			for j := i + 1; j < n; j++ {
+				off += a[i][j] * a[i][j]
+			}
+		}
+		if off < 1e-30 {
+			break
+		}
+
+		for p := 0; p < n; p++ {
+			for q := p + 1; q < n; q++ {
+				if a[p][q] == 0 {
+					continue
+				}
+				theta := (a[q][q] - a[p][p]) / (2 * a[p][q])
+				t := 1 / (math.Abs(theta) + math.Sqrt(theta*theta+1))
+				if theta < 0 {
+					t = -t
+				}
+				c := 1 / math.Sqrt(t*t+1)
+				s := t * c
+
+				for k := 0; k < n; k++ {
+					akp, akq := a[k][p], a[k][q]
+					a[k][p] = c*akp - s*akq
+					a[k][q] = s*akp + c*akq
+				}
+				for k := 0; k < n; k++ {
+					apk, aqk := a[p][k], a[q][k]
+					a[p][k] = c*apk - s*aqk
+					a[q][k] = s*apk + c*aqk
+				}
+				for k := 0; k < n; k++ {
+					vkp, vkq := v[k][p], v[k][q]
+					v[k][p] = c*vkp - s*vkq
+					v[k][q] = s*vkp + c*vkq
+				}
+			}
+		}
+	}
+
+	values := make([]float64, n)
+	for i := range values {
+		values[i] = a[i][i]
+	}
+	return values, v
+}
+
+// Computes the Mahalanobis distance between two vectors given the inverse covariance matrix
+// Mahalanobis(a,b) = sqrt((a-b)^T * invCov * (a-b))
+// Unlike Euclidean distance, it accounts for the scale of and correlation between dimensions
+// Reference: https://en.wikipedia.org/wiki/Mahalanobis_distance
+func Mahalanobis(a Vector, b Vector, invCov Matrix) float64 {
+	result, err := TryMahalanobis(a, b, invCov)
+	if err != nil {
+		panic(err)
+	}
+	return result
+}
+
+// Computes the Mahalanobis distance between two vectors, returning an error on invalid input
+func TryMahalanobis(a Vector, b Vector, invCov Matrix) (float64, error) {
+	if err := validate(a, b); err != nil {
+		return 0, err
+	}
+	if len(invCov) != a.Length() || !invCov.isSquare() {
+		return 0, ErrDimensionMismatch
+	}
+
+	diff := Subtract(a, b)
+	q := diff.Dot(invCov.MulVec(diff))
+	// Rounding can make the quadratic form very slightly negative for near-identical vectors
+	if q < 0 {
+		q = 0
+	}
+	return math.Sqrt(q), nil
+}
@@ -0,0 +1,131 @@
+package vector
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func matricesEqual(a Matrix, b Matrix) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if len(a[i]) != len(b[i]) {
+			return false
+		}
+		for j := range a[i] {
+			if math.Abs(a[i][j]-b[i][j]) > floatDifferenceThresh {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func mul(a Matrix, b Matrix) Matrix {
+	result := NewMatrix(len(a), len(b[0]))
+	for i := range a {
+		for j := range b[0] {
+			for k := range b {
+				result[i][j] += a[i][k] * b[k][j]
+			}
+		}
+	}
+	return result
+}
+
+func TestCovarianceMatrix(t *testing.T) {
+	var tests = []struct {
+		data     []Vector
+		expected Matrix
+	}{
+		{[]Vector{{1, 2}, {2, 4}, {3, 6}}, Matrix{{1, 2}, {2, 4}}},
+		{[]Vector{{43, 99}, {21, 65}, {25, 79}, {42, 75}, {57, 87}, {59, 81}}, Matrix{
+			{Covariance(Vector{43, 21, 25, 42, 57, 59}, Vector{43, 21, 25, 42, 57, 59}), Covariance(Vector{43, 21, 25, 42, 57, 59}, Vector{99, 65, 79, 75, 87, 81})},
+			{Covariance(Vector{99, 65, 79, 75, 87, 81}, Vector{43, 21, 25, 42, 57, 59}), Covariance(Vector{99, 65, 79, 75, 87, 81}, Vector{99, 65, 79, 75, 87, 81})},
+		}},
+	}
+
+	for _, test := range tests {
+		if output := CovarianceMatrix(test.data); !matricesEqual(output, test.expected) {
+			t.Error("Test Failed,", test.expected, " expected,", output, " received.")
+		}
+	}
+
+	if _, err := TryCovarianceMatrix([]Vector{{1, 2}}); !errors.Is(err, ErrInsufficientData) {
+		t.Error("Test Failed,", ErrInsufficientData, " expected,", err, " received.")
+	}
+	if _, err := TryCovarianceMatrix([]Vector{{1, 2}, {1}}); !errors.Is(err, ErrDimensionMismatch) {
+		t.Error("Test Failed,", ErrDimensionMismatch, " expected,", err, " received.")
+	}
+}
+
+func TestInverse(t *testing.T) {
+	var tests = []struct {
+		m        Matrix
+		expected Matrix
+	}{
+		{Matrix{{4, 7}, {2, 6}}, Matrix{{0.6, -0.7}, {-0.2, 0.4}}},
+		{Matrix{{0, 1}, {1, 0}}, Matrix{{0, 1}, {1, 0}}},
+		{Matrix{{2, -1, 0}, {-1, 2, -1}, {0, -1, 2}}, Matrix{{0.75, 0.5, 0.25}, {0.5, 1, 0.5}, {0.25, 0.5, 0.75}}},
+	}
+
+	for _, test := range tests {
+		if output, err := Inverse(test.m); err != nil || !matricesEqual(output, test.expected) {
+			t.Error("Test Failed,", test.expected, " expected,", output, err, " received.")
+		}
+	}
+
+	if _, err := Inverse(Matrix{{1, 2}, {2, 4}}); !errors.Is(err, ErrSingularMatrix) {
+		t.Error("Test Failed,", ErrSingularMatrix, " expected,", err, " received.")
+	}
+}
+
+func TestRegularizedInverse(t *testing.T) {
+	m := Matrix{{1, 2}, {2, 4}}
+	output, err := RegularizedInverse(m, 1)
+	expected, _ := Inverse(Matrix{{2, 2}, {2, 5}})
+	if err != nil || !matricesEqual(output, expected) {
+		t.Error("Test Failed,", expected, " expected,", output, err, " received.")
+	}
+}
+
+func TestPseudoInverse(t *testing.T) {
+	var tests = []Matrix{
+		{{1, 2}, {2, 4}},
+		{{2, -1, 0}, {-1, 2, -1}, {0, -1, 2}},
+		{{1, 1, 1}, {1, 1, 1}, {1, 1, 1}},
+	}
+
+	for _, m := range tests {
+		p, err := PseudoInverse(m)
+		// Moore-Penrose conditions: A*P*A = A and P*A*P = P
+		if err != nil || !matricesEqual(mul(mul(m, p), m), m) || !matricesEqual(mul(mul(p, m), p), p) {
+			t.Error("Test Failed, pseudo-inverse of", m, " received", p, err)
+		}
+	}
+}
+
+func TestMahalanobis(t *testing.T) {
+	var tests = []struct {
+		v1       Vector
+		v2       Vector
+		invCov   Matrix
+		expected float64
+	}{
+		{Vector{3, 2}, Vector{4, 1}, Identity(2), math.Sqrt(2)},
+		{Vector{0, 0}, Vector{2, 3}, Matrix{{0.25, 0}, {0, 1.0 / 9}}, math.Sqrt(2)},
+		{Vector{1, 2, 3}, Vector{1, 2, 3}, Identity(3), 0},
+	}
+
+	for _, test := range tests {
+		if output := Mahalanobis(test.v1, test.v2, test.invCov); math.Abs(output-test.expected) > floatDifferenceThresh {
+			t.Error("Test Failed,", test.expected, " expected,", output, " received.")
+		}
+	}
+
+	if _, err := TryMahalanobis(Vector{1, 2}, Vector{1, 2}, Identity(3)); !errors.Is(err, ErrDimensionMismatch) {
+		t.Error("Test Failed,", ErrDimensionMismatch, " expected,", err, " received.")
+	}
+}
@@ -0,0 +1,127 @@
+package vector
+
+import (
+	"math"
+)
+
+// Computes the Minkowski distance between two vectors for a real-valued order p
+// p = 1 and p = 2 give the Manhattan and Euclidean distances, and p = math.Inf(1) gives the Chebyshev distance.
+// For 0 < p < 1 the triangle inequality does not hold, so the result is only a quasi-metric.
+func MinkowskiFloat(a Vector, b Vector, p float64) float64 {
+	return WeightedMinkowski(a, b, nil, p)
+}
+
+// Computes the real-valued Minkowski distance between two vectors, returning an error on invalid input
+func TryMinkowskiFloat(a Vector, b Vector, p float64) (float64, error) {
+	return TryWeightedMinkowski(a, b, nil, p)
+}
+
+// Computes the weighted Minkowski distance between two vectors
+// WeightedMinkowski(a,b) = (sum(w_i * |a_i - b_i|^p))^(1/p)
+// A nil weight vector weighs every dimension equally. For p = math.Inf(1) the result is
+// the weighted Chebyshev distance max(w_i * |a_i - b_i|).
+func WeightedMinkowski(a Vector, b Vector, w Vector, p float64) float64 {
+	result, err := TryWeightedMinkowski(a, b, w, p)
+	if err != nil {
+		panic(err)
+	}
+	return result
+}
+
+// Computes the weighted Minkowski distance between two vectors, returning an error on invalid input
+// ErrInvalidParameter is returned if p is not positive, and ErrNegativeWeight if any weight is negative
+func TryWeightedMinkowski(a Vector, b Vector, w Vector, p float64) (float64, error) {
+	if err := validateWeights(a, b, w); err != nil {
+		return 0, err
+	}
+	if !(p > 0) {
+		return 0, ErrInvalidParameter
+	}
+
+	if math.IsInf(p, 1) {
+		return weightedChebyshev(a, b, w), nil
+	}
+
+	var result float64 = 0
+	for i := 0; i < a.Length(); i++ {
+		d := math.Abs(a[i] - b[i])
+		if p == 2 {
+			d = d * d
+		} else if p != 1 {
+			d = math.Pow(d, p)
+		}
+		if w != nil {
+			d *= w[i]
+		}
+		result += d
+	}
+
+	switch p {
+	case 1:
+		return result, nil
+	case 2:
+		return math.Sqrt(result), nil
+	}
+	return math.Pow(result, 1/p), nil
+}
+
+// Computes the weighted Manhattan distance between two vectors
+// WeightedManhattan(a,b) = sum(w_i * |a_i - b_i|)
+func WeightedManhattan(a Vector, b Vector, w Vector) float64 {
+	return WeightedMinkowski(a, b, w, 1)
+}
+
+// Computes the weighted Euclidean distance between two vectors
+// WeightedEuclidean(a,b) = sqrt(sum(w_i * (a_i - b_i)^2))
+func WeightedEuclidean(a Vector, b Vector, w Vector) float64 {
+	return WeightedMinkowski(a, b, w, 2)
+}
+
+// Computes the weighted Squared euclidean distance between two vectors
+func WeightedSquaredEuclidean(a Vector, b Vector, w Vector) float64 {
+	d := WeightedEuclidean(a, b, w)
+	return d * d
+}
+
+// Computes the weighted Chebyshev distance between two vectors
+// WeightedChebyshev(a,b) = max(w_i * |a_i - b_i|)
+func WeightedChebyshev(a Vector, b Vector, w Vector) float64 {
+	return WeightedMinkowski(a, b, w, math.Inf(1))
+}
+
+func weightedChebyshev(a Vector, b Vector, w Vector) float64 {
+	var result float64 = 0
+	for i := 0; i < a.Length(); i++ {
+		d := math.Abs(a[i] - b[i])
+		if w != nil {
+			d *= w[i]
+		}
+		if d > result {
+			result = d
+		}
+	}
+	return result
+}
+
+// Validates a pair of vectors and an optional weight vector
+func validateWeights(a Vector, b Vector, w Vector) error {
+	if err := validate(a, b); err != nil {
+		return err
+	}
+	if w == nil {
+		return nil
+	}
+
+	if w.Length() != a.Length() {
+		return ErrDimensionMismatch
+	}
+	if w.hasNaN() {
+		return ErrNaN
+	}
+	for _, value := range w {
+		if value < 0 {
+			return ErrNegativeWeight
+		}
+	}
+	return nil
+}
@@ -0,0 +1,90 @@
+package vector
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestMinkowskiFloat(t *testing.T) {
+	var tests = []struct {
+		v1       Vector
+		v2       Vector
+		p        float64
+		expected float64
+	}{
+		{Vector{10, 2, 4, -1, 0, 9, 1}, Vector{14, 7, 11, 5, 2, 2, 18}, 4, 17.3452},
+		{Vector{22, 1, 42, 10}, Vector{20, 0, 36, 8}, 2, 6.7082},
+		{Vector{2, 4, 4, 6}, Vector{5, 5, 7, 8}, 1, 9},
+		{Vector{0, 0}, Vector{1, 1}, 0.5, 4},
+		{Vector{0, 0}, Vector{3, 4}, 1.5, math.Pow(math.Pow(3, 1.5)+math.Pow(4, 1.5), 1/1.5)},
+		{Vector{1, 2, 3, 4, 5, 6, 7, 8}, Vector{2, 4, 6, 8, 10, 20, 11, 16}, math.Inf(1), 14},
+	}
+
+	for _, test := range tests {
+		if output := MinkowskiFloat(test.v1, test.v2, test.p); math.Abs(output-test.expected) > floatDifferenceThresh {
+			t.Error("Test Failed,", test.expected, " expected,", output, " received.")
+		}
+	}
+}
+
+func TestWeightedMinkowski(t *testing.T) {
+	var tests = []struct {
+		v1       Vector
+		v2       Vector
+		w        Vector
+		p        float64
+		expected float64
+	}{
+		{Vector{2, 4, 4, 6}, Vector{5, 5, 7, 8}, Vector{1, 1, 1, 1}, 1, 9},
+		{Vector{2, 4, 4, 6}, Vector{5, 5, 7, 8}, Vector{2, 0, 1, 0.5}, 1, 10},
+		{Vector{0, 0}, Vector{3, 4}, Vector{4, 1}, 2, math.Sqrt(52)},
+		{Vector{0, 0}, Vector{3, 4}, Vector{4, 1}, math.Inf(1), 12},
+		{Vector{0, 0}, Vector{3, 4}, nil, 3, math.Cbrt(91)},
+	}
+
+	for _, test := range tests {
+		if output := WeightedMinkowski(test.v1, test.v2, test.w, test.p); math.Abs(output-test.expected) > floatDifferenceThresh {
+			t.Error("Test Failed,", test.expected, " expected,", output, " received.")
+		}
+	}
+}
+
+func TestWeightedNamed(t *testing.T) {
+	a, b, w := Vector{1, 2, 3}, Vector{4, 0, 3}, Vector{0.5, 2, 1}
+	var tests = []struct {
+		output   float64
+		expected float64
+	}{
+		{WeightedManhattan(a, b, w), 5.5},
+		{WeightedEuclidean(a, b, w), math.Sqrt(12.5)},
+		{WeightedSquaredEuclidean(a, b, w), 12.5},
+		{WeightedChebyshev(a, b, w), 4},
+	}
+
+	for _, test := range tests {
+		if math.Abs(test.output-test.expected) > floatDifferenceThresh {
+			t.Error("Test Failed,", test.expected, " expected,", test.output, " received.")
+		}
+	}
+}
+
+func TestWeightedMinkowskiErrors(t *testing.T) {
+	var tests = []struct {
+		w        Vector
+		p        float64
+		expected error
+	}{
+		{Vector{1, 1}, 0, ErrInvalidParameter},
+		{Vector{1, 1}, -2, ErrInvalidParameter},
+		{Vector{1, 1}, math.NaN(), ErrInvalidParameter},
+		{Vector{1}, 2, ErrDimensionMismatch},
+		{Vector{1, -1}, 2, ErrNegativeWeight},
+	}
+
+	for _, test := range tests {
+		if _, err := TryWeightedMinkowski(Vector{1, 2}, Vector{3, 4}, test.w, test.p); !errors.Is(err, test.expected) {
+			t.Error("Test Failed,", test.expected, " expected,", err, " received.")
+		}
+	}
+}
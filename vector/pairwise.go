@@ -0,0 +1,155 @@
+package vector
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+	"sync"
+)
+
+// DistanceFunc is any function computing a distance (or similarity) between two vectors,
+// e.g. Euclidean, Manhattan or CosineSimilarity
+type DistanceFunc func(a Vector, b Vector) float64
+
+// Condensed is the condensed form of a symmetric pairwise distance matrix
+// Only the entries above the diagonal are stored, row by row, so n rows need n*(n-1)/2 values
+type Condensed struct {
+	N    int
+	Data []float64
+}
+
+// Returns the index into Data of the entry (i, j) with i < j
+func (c *Condensed) index(i int, j int) int {
+	return c.N*i - i*(i+1)/2 + j - i - 1
+}
+
+// Returns the distance between rows i and j
+// The distance of a row to itself is 0
+func (c *Condensed) At(i int, j int) float64 {
+	if i < 0 || j < 0 || i >= c.N || j >= c.N {
+		panic(ErrIndexOutOfRange)
+	}
+	if i == j {
+		return 0
+	}
+	if i > j {
+		i, j = j, i
+	}
+	return c.Data[c.index(i, j)]
+}
+
+// Expands the condensed matrix into a full n x n matrix with a zero diagonal
+func (c *Condensed) Square() Matrix {
+	m := NewMatrix(c.N, c.N)
+	for i := 0; i < c.N; i++ {
+		for j := i + 1; j < c.N; j++ {
+			d := c.Data[c.index(i, j)]
+			m[i][j], m[j][i] = d, d
+		}
+	}
+	return m
+}
+
+// Computes the distances between every pair of rows
+// The metric is assumed to be symmetric with a zero self-distance, so each pair is computed once
+// and the result is returned in condensed form. For similarities such as CosineSimilarity,
+// whose self-similarity is not 0, use Cross(ctx, rows, rows, ...) instead.
+// The work is split across the given number of goroutines (runtime.GOMAXPROCS(0) if workers <= 0),
+// and ctx.Err() is returned if the context is cancelled before all rows are done.
+// A panic inside the metric (e.g. on vectors of different lengths) is returned as an error.
+func Pairwise(ctx context.Context, rows []Vector, metric DistanceFunc, workers int) (*Condensed, error) {
+	n := len(rows)
+	c := &Condensed{N: n, Data: make([]float64, n*(n-1)/2)}
+
+	err := parallelRows(ctx, n, workers, func(i int) {
+		offset := c.index(i, i+1)
+		for j := i + 1; j < n; j++ {
+			c.Data[offset+j-i-1] = metric(rows[i], rows[j])
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// Computes the distances between every row of xs and every row of ys
+// Entry (i, j) of the returned len(xs) x len(ys) matrix is metric(xs[i], ys[j])
+// Workers, cancellation and panics are handled as in Pairwise
+func Cross(ctx context.Context, xs []Vector, ys []Vector, metric DistanceFunc, workers int) (Matrix, error) {
+	m := NewMatrix(len(xs), len(ys))
+
+	err := parallelRows(ctx, len(xs), workers, func(i int) {
+		for j := range ys {
+			m[i][j] = metric(xs[i], ys[j])
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// Runs f for every row index in [0, n) across a pool of goroutines
+// It stops handing out rows once the context is cancelled or f panics
+func parallelRows(ctx context.Context, n int, workers int, f func(i int)) error {
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	if workers > n {
+		workers = n
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var once sync.Once
+	var failure error
+	fail := func(err error) {
+		once.Do(func() {
+			failure = err
+			cancel()
+		})
+	}
+
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() {
+				if r := recover(); r != nil {
+					if err, ok := r.(error); ok {
+						fail(err)
+					} else {
+						fail(fmt.Errorf("vector: metric panicked: %v", r))
+					}
+				}
+			}()
+			for i := range jobs {
+				f(i)
+			}
+		}()
+	}
+
+	fed := 0
+feed:
+	for ; fed < n && ctx.Err() == nil; fed++ {
+		select {
+		case jobs <- fed:
+		case <-ctx.Done():
+			break feed
+		}
+	}
+	close(jobs)
+	wg.Wait()
+
+	if failure != nil {
+		return failure
+	}
+	if fed < n {
+		return ctx.Err()
+	}
+	return nil
+}
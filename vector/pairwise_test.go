@@ -0,0 +1,64 @@
+package vector
+
+import (
+	"context"
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestPairwise(t *testing.T) {
+	rows := []Vector{{0, 0}, {3, 4}, {6, 8}, {-3, -4}}
+	expected := Matrix{
+		{0, 5, 10, 5},
+		{5, 0, 5, 10},
+		{10, 5, 0, 15},
+		{5, 10, 15, 0},
+	}
+
+	for _, workers := range []int{0, 1, 3, 16} {
+		c, err := Pairwise(context.Background(), rows, Euclidean, workers)
+		if err != nil || len(c.Data) != 6 || !matricesEqual(c.Square(), expected) {
+			t.Error("Test Failed,", expected, " expected,", c, err, " received.")
+			continue
+		}
+		for i := range expected {
+			for j := range expected[i] {
+				if output := c.At(i, j); math.Abs(output-expected[i][j]) > floatDifferenceThresh {
+					t.Error("Test Failed,", expected[i][j], " expected,", output, " received.")
+				}
+			}
+		}
+	}
+
+	if c, err := Pairwise(context.Background(), nil, Euclidean, 2); err != nil || c.N != 0 || len(c.Data) != 0 {
+		t.Error("Test Failed, empty matrix expected,", c, err, " received.")
+	}
+}
+
+func TestCross(t *testing.T) {
+	xs := []Vector{{1, 0}, {0, 1}}
+	ys := []Vector{{1, 0}, {1, 1}, {0, 2}}
+	expected := Matrix{
+		{1, 1 / math.Sqrt(2), 0},
+		{0, 1 / math.Sqrt(2), 1},
+	}
+
+	if output, err := Cross(context.Background(), xs, ys, CosineSimilarity, 2); err != nil || !matricesEqual(output, expected) {
+		t.Error("Test Failed,", expected, " expected,", output, err, " received.")
+	}
+}
+
+func TestPairwiseErrors(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	rows := []Vector{{1}, {2}, {3}}
+	if _, err := Pairwise(ctx, rows, Euclidean, 1); !errors.Is(err, context.Canceled) {
+		t.Error("Test Failed,", context.Canceled, " expected,", err, " received.")
+	}
+
+	rows = []Vector{{1, 2}, {2}, {3, 4}}
+	if _, err := Cross(context.Background(), rows, rows, Euclidean, 2); !errors.Is(err, ErrDimensionMismatch) {
+		t.Error("Test Failed,", ErrDimensionMismatch, " expected,", err, " received.")
+	}
+}
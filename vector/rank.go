@@ -0,0 +1,200 @@
+package vector
+
+import (
+	"math"
+	"sort"
+)
+
+// Computes the ranks (starting at 1) of the elements in a vector
+// Tied elements are all given the average of the ranks they span, e.g. {10, 20, 20, 30} -> {1, 2.5, 2.5, 4}
+func Rank(v Vector) Vector {
+	idx := make([]int, v.Length())
+	for i := range idx {
+		idx[i] = i
+	}
+	sort.SliceStable(idx, func(i, j int) bool { return v[idx[i]] < v[idx[j]] })
+
+	ranks := make(Vector, v.Length())
+	for i := 0; i < len(idx); {
+		j := i + 1
+		for j < len(idx) && v[idx[j]] == v[idx[i]] {
+			j++
+		}
+		// Positions i..j-1 hold a run of ties sharing ranks i+1..j
+		avg := float64(i+j+1) / 2
+		for k := i; k < j; k++ {
+			ranks[idx[k]] = avg
+		}
+		i = j
+	}
+	return ranks
+}
+
+// Computes Spearman's rank correlation coefficient (rho) between two vectors
+// Spearman's rho is the Pearson correlation of the ranks of the vectors, so it measures
+// how well the relationship between them can be described by a monotonic function.
+// The returned correlation varies between -1 and 1
+// Reference: https://en.wikipedia.org/wiki/Spearman%27s_rank_correlation_coefficient
+func SpearmanCorrelation(a Vector, b Vector) float64 {
+	result, err := TrySpearmanCorrelation(a, b)
+	if err != nil {
+		panic(err)
+	}
+	return result
+}
+
+// Computes Spearman's rank correlation coefficient between two vectors, returning an error on invalid input
+// ErrZeroVariance is returned if either vector is constant
+func TrySpearmanCorrelation(a Vector, b Vector) (float64, error) {
+	if err := validate(a, b); err != nil {
+		return 0, err
+	}
+	return TryPearsonCorrelation(Rank(a), Rank(b))
+}
+
+// Computes the Spearman distance between two vectors, i.e. 1 - SpearmanCorrelation(a,b)
+// The distance ranges between 0 and 2
+func SpearmanDistance(a Vector, b Vector) float64 {
+	result, err := TrySpearmanDistance(a, b)
+	if err != nil {
+		panic(err)
+	}
+	return result
+}
+
+// Computes the Spearman distance between two vectors, returning an error on invalid input
+func TrySpearmanDistance(a Vector, b Vector) (float64, error) {
+	correlation, err := TrySpearmanCorrelation(a, b)
+	if err != nil {
+		return 0, err
+	}
+	return 1 - correlation, nil
+}
+
+// Computes Kendall's tau-b rank correlation coefficient between two vectors
+// Tau-b corrects for ties in either vector and varies between -1 and 1
+// Time complexity : O(n log n), using Knight's merge sort algorithm to count discordant pairs
+// Reference: https://en.wikipedia.org/wiki/Kendall_rank_correlation_coefficient
+func KendallTau(a Vector, b Vector) float64 {
+	result, err := TryKendallTau(a, b)
+	if err != nil {
+		panic(err)
+	}
+	return result
+}
+
+// Computes Kendall's tau-b between two vectors, returning an error on invalid input
+// ErrZeroVariance is returned if either vector is constant
+func TryKendallTau(a Vector, b Vector) (float64, error) {
+	if err := validate(a, b); err != nil {
+		return 0, err
+	}
+
+	n := a.Length()
+	idx := make([]int, n)
+	for i := range idx {
+		idx[i] = i
+	}
+	sort.Slice(idx, func(i, j int) bool {
+		if a[idx[i]] != a[idx[j]] {
+			return a[idx[i]] < a[idx[j]]
+		}
+		return b[idx[i]] < b[idx[j]]
+	})
+
+	// Pairs tied in a (n1) and tied in both a and b (n3)
+	var n1, n3 int64
+	for i := 0; i < n; {
+		j := i + 1
+		for j < n && a[idx[j]] == a[idx[i]] {
+			j++
+		}
+		n1 += pairs(j - i)
+		for k := i; k < j; {
+			l := k + 1
+			for l < j && b[idx[l]] == b[idx[k]] {
+				l++
+			}
+			n3 += pairs(l - k)
+			k = l
+		}
+		i = j
+	}
+
+	// Discordant pairs are the inversions of b when ordered by a
+	ys := make([]float64, n)
+	for i, k := range idx {
+		ys[i] = b[k]
+	}
+	swaps := mergeCount(ys, make([]float64, n))
+
+	// Pairs tied in b (n2), ys is now sorted
+	var n2 int64
+	for i := 0; i < n; {
+		j := i + 1
+		for j < n && ys[j] == ys[i] {
+			j++
+		}
+		n2 += pairs(j - i)
+		i = j
+	}
+
+	n0 := pairs(n)
+	if n0 == n1 || n0 == n2 {
+		return 0, ErrZeroVariance
+	}
+	num := float64(n0 - n1 - n2 + n3 - 2*swaps)
+	return num / math.Sqrt(float64(n0-n1)*float64(n0-n2)), nil
+}
+
+// Computes the normalized Kendall distance between two vectors, i.e. (1 - KendallTau(a,b)) / 2
+// Without ties this is the fraction of pairs ranked in opposite order, ranging between 0 and 1
+func KendallDistance(a Vector, b Vector) float64 {
+	result, err := TryKendallDistance(a, b)
+	if err != nil {
+		panic(err)
+	}
+	return result
+}
+
+// Computes the normalized Kendall distance between two vectors, returning an error on invalid input
+func TryKendallDistance(a Vector, b Vector) (float64, error) {
+	tau, err := TryKendallTau(a, b)
+	if err != nil {
+		return 0, err
+	}
+	return (1 - tau) / 2, nil
+}
+
+// Returns the number of unordered pairs among n elements
+func pairs(n int) int64 {
+	return int64(n) * int64(n-1) / 2
+}
+
+// Sorts v in place with merge sort and returns the number of inversions
+func mergeCount(v []float64, buf []float64) int64 {
+	n := len(v)
+	if n < 2 {
+		return 0
+	}
+
+	mid := n / 2
+	count := mergeCount(v[:mid], buf[:mid]) + mergeCount(v[mid:], buf[mid:])
+
+	i, j, k := 0, mid, 0
+	for i < mid && j < n {
+		if v[j] < v[i] {
+			buf[k] = v[j]
+			count += int64(mid - i)
+			j++
+		} else {
+			buf[k] = v[i]
+			i++
+		}
+		k++
+	}
+	k += copy(buf[k:], v[i:mid])
+	copy(buf[k:], v[j:])
+	copy(v, buf[:n])
+	return count
+}
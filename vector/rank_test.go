@@ -0,0 +1,106 @@
+package vector
+
+import (
+	"errors"
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestRank(t *testing.T) {
+	var tests = []struct {
+		v        Vector
+		expected Vector
+	}{
+		{Vector{10, 20, 20, 30}, Vector{1, 2.5, 2.5, 4}},
+		{Vector{3, 1, 2}, Vector{3, 1, 2}},
+		{Vector{5, 5, 5}, Vector{2, 2, 2}},
+	}
+
+	for _, test := range tests {
+		if output := Rank(test.v); !reflect.DeepEqual(test.expected, output) {
+			t.Error("Test Failed,", test.expected, " expected,", output, " received.")
+		}
+	}
+}
+
+func TestSpearmanCorrelation(t *testing.T) {
+	var tests = []struct {
+		v1       Vector
+		v2       Vector
+		expected float64
+	}{
+		{Vector{1, 2, 3, 4, 5}, Vector{1, 4, 9, 16, 25}, 1},
+		{Vector{1, 2, 3, 4, 5}, Vector{5, 6, 7, 8, 7}, 0.8208},
+		{Vector{106, 86, 100, 101, 99, 103, 97, 113, 112, 110}, Vector{7, 0, 27, 50, 28, 29, 20, 12, 6, 17}, -0.1758},
+	}
+
+	for _, test := range tests {
+		if output := SpearmanCorrelation(test.v1, test.v2); math.Abs(output-test.expected) > floatDifferenceThresh {
+			t.Error("Test Failed,", test.expected, " expected,", output, " received.")
+		}
+		if output := SpearmanDistance(test.v1, test.v2); math.Abs(output-(1-test.expected)) > floatDifferenceThresh {
+			t.Error("Test Failed,", 1-test.expected, " expected,", output, " received.")
+		}
+	}
+}
+
+// Computes tau-b directly from all pairs for comparison
+func naiveKendallTau(a Vector, b Vector) float64 {
+	var s, ta, tb float64
+	for i := 0; i < a.Length(); i++ {
+		for j := i + 1; j < a.Length(); j++ {
+			da, db := math.Copysign(1, a[i]-a[j]), math.Copysign(1, b[i]-b[j])
+			if a[i] == a[j] {
+				da = 0
+			}
+			if b[i] == b[j] {
+				db = 0
+			}
+			s += da * db
+			ta += da * da
+			tb += db * db
+		}
+	}
+	return s / math.Sqrt(ta*tb)
+}
+
+func TestKendallTau(t *testing.T) {
+	var tests = []struct {
+		v1 Vector
+		v2 Vector
+	}{
+		{Vector{1, 2, 3, 4, 5}, Vector{5, 6, 7, 8, 7}},
+		{Vector{12, 2, 1, 12, 2}, Vector{1, 4, 7, 1, 0}},
+		{Vector{1, 2, 3, 4, 5}, Vector{5, 4, 3, 2, 1}},
+		{Vector{3, 1, 4, 1, 5, 9, 2, 6, 5, 3, 5}, Vector{2, 7, 1, 8, 2, 8, 1, 8, 2, 8, 4}},
+	}
+
+	for _, test := range tests {
+		expected := naiveKendallTau(test.v1, test.v2)
+		if output := KendallTau(test.v1, test.v2); math.Abs(output-expected) > floatDifferenceThresh {
+			t.Error("Test Failed,", expected, " expected,", output, " received.")
+		}
+		if output := KendallDistance(test.v1, test.v2); math.Abs(output-(1-expected)/2) > floatDifferenceThresh {
+			t.Error("Test Failed,", (1-expected)/2, " expected,", output, " received.")
+		}
+	}
+
+	if output := KendallTau(Vector{12, 2, 1, 12, 2}, Vector{1, 4, 7, 1, 0}); math.Abs(output-(-0.4714)) > floatDifferenceThresh {
+		t.Error("Test Failed,", -0.4714, " expected,", output, " received.")
+	}
+	if _, err := TryKendallTau(Vector{1, 1, 1}, Vector{1, 2, 3}); !errors.Is(err, ErrZeroVariance) {
+		t.Error("Test Failed,", ErrZeroVariance, " expected,", err, " received.")
+	}
+}
+
+func TestRankDistanceErrors(t *testing.T) {
+	for _, f := range []func(a Vector, b Vector) (float64, error){TrySpearmanDistance, TryKendallDistance} {
+		if _, err := f(Vector{1, 2, 3}, Vector{1, 2}); !errors.Is(err, ErrDimensionMismatch) {
+			t.Error("Test Failed,", ErrDimensionMismatch, " expected,", err, " received.")
+		}
+		if _, err := f(Vector{1, 1, 1}, Vector{1, 2, 3}); !errors.Is(err, ErrZeroVariance) {
+			t.Error("Test Failed,", ErrZeroVariance, " expected,", err, " received.")
+		}
+	}
+}
@@ -0,0 +1,396 @@
+package vector
+
+import (
+	"math"
+	"sort"
+)
+
+// Sparse is a sparse float64 vector of dimension Dim
+// Only the non-zero elements are stored, as strictly increasing Indices with the matching Values,
+// so every metric on two sparse vectors runs in O(nnz) regardless of the dimension.
+// Use NewSparse or ToSparse to build a valid Sparse vector.
+type Sparse struct {
+	Dim     int
+	Indices []int
+	Values  []float64
+}
+
+// Initialize a new sparse vector of dimension dim from unordered (index, value) pairs
+// Values at the same index are summed and zero values are dropped.
+// ErrDimensionMismatch is returned if indices and values differ in length, ErrIndexOutOfRange if an index
+// is outside [0, dim) and ErrNaN if a value is NaN.
+func NewSparse(dim int, indices []int, values []float64) (Sparse, error) {
+	if len(indices) != len(values) {
+		return Sparse{}, ErrDimensionMismatch
+	}
+
+	order := make([]int, len(indices))
+	for i, index := range indices {
+		if index < 0 || index >= dim {
+			return Sparse{}, ErrIndexOutOfRange
+		}
+		if math.IsNaN(values[i]) {
+			return Sparse{}, ErrNaN
+		}
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool { return indices[order[i]] < indices[order[j]] })
+
+	s := Sparse{Dim: dim, Indices: []int{}, Values: []float64{}}
+	for k := 0; k < len(order); {
+		index, sum := indices[order[k]], 0.0
+		for ; k < len(order) && indices[order[k]] == index; k++ {
+			sum += values[order[k]]
+		}
+		if sum != 0 {
+			s.Indices = append(s.Indices, index)
+			s.Values = append(s.Values, sum)
+		}
+	}
+	return s, nil
+}
+
+// Converts a dense vector into a sparse vector holding its non-zero elements
+func ToSparse(v Vector) Sparse {
+	s := Sparse{Dim: v.Length(), Indices: []int{}, Values: []float64{}}
+	for i, value := range v {
+		if value != 0 {
+			s.Indices = append(s.Indices, i)
+			s.Values = append(s.Values, value)
+		}
+	}
+	return s
+}
+
+// Converts the sparse vector into a dense vector
+func (s Sparse) ToDense() Vector {
+	v := make(Vector, s.Dim)
+	for k, index := range s.Indices {
+		v[index] = s.Values[k]
+	}
+	return v
+}
+
+// Returns the number of stored (non-zero) elements
+func (s Sparse) Nnz() int {
+	return len(s.Indices)
+}
+
+// Computes the element at a particular index, which is 0 for indices that are not stored
+func (s Sparse) At(index int) float64 {
+	if index < 0 || index >= s.Dim {
+		panic(ErrIndexOutOfRange)
+	}
+	k := sort.SearchInts(s.Indices, index)
+	if k < len(s.Indices) && s.Indices[k] == index {
+		return s.Values[k]
+	}
+	return 0
+}
+
+// Computes the magnitude of a sparse vector
+func (s Sparse) Magnitude() float64 {
+	var result float64 = 0
+	for _, value := range s.Values {
+		result += value * value
+	}
+	return math.Sqrt(result)
+}
+
+// Computes the Dot product between two sparse vectors of the same dimension
+func (s Sparse) Dot(o Sparse) float64 {
+	if s.Dim != o.Dim {
+		panic(ErrDimensionMismatch)
+	}
+
+	var result float64 = 0
+	i, j := 0, 0
+	for i < len(s.Indices) && j < len(o.Indices) {
+		switch {
+		case s.Indices[i] < o.Indices[j]:
+			i++
+		case s.Indices[i] > o.Indices[j]:
+			j++
+		default:
+			result += s.Values[i] * o.Values[j]
+			i++
+			j++
+		}
+	}
+	return result
+}
+
+// Computes the Dot product between a sparse vector and a dense vector of the same dimension in O(nnz)
+func (s Sparse) DotDense(v Vector) float64 {
+	if s.Dim != v.Length() {
+		panic(ErrDimensionMismatch)
+	}
+
+	var result float64 = 0
+	for k, index := range s.Indices {
+		result += s.Values[k] * v[index]
+	}
+	return result
+}
+
+// Validates a pair of sparse vectors before computing a metric on them
+func validateSparse(a Sparse, b Sparse) error {
+	if a.Dim == 0 || b.Dim == 0 {
+		return ErrEmptyVector
+	}
+	if a.Dim != b.Dim {
+		return ErrDimensionMismatch
+	}
+	return nil
+}
+
+// Calls f with the element-wise differences a_i - b_i over the union of the supports of a and b
+// Indices outside both supports have a zero difference and are skipped
+func sparseDifferences(a Sparse, b Sparse, f func(d float64)) {
+	i, j := 0, 0
+	for i < len(a.Indices) || j < len(b.Indices) {
+		switch {
+		case j == len(b.Indices) || (i < len(a.Indices) && a.Indices[i] < b.Indices[j]):
+			f(a.Values[i])
+			i++
+		case i == len(a.Indices) || a.Indices[i] > b.Indices[j]:
+			f(-b.Values[j])
+			j++
+		default:
+			f(a.Values[i] - b.Values[j])
+			i++
+			j++
+		}
+	}
+}
+
+// Calls f with the element-wise differences s_i - v_i over every index of the dense vector
+func denseDifferences(s Sparse, v Vector, f func(d float64)) {
+	k := 0
+	for i, value := range v {
+		if k < len(s.Indices) && s.Indices[k] == i {
+			f(s.Values[k] - value)
+			k++
+		} else {
+			f(-value)
+		}
+	}
+}
+
+// Computes the Cosine similarity between two sparse vectors
+func SparseCosineSimilarity(a Sparse, b Sparse) float64 {
+	result, err := TrySparseCosineSimilarity(a, b)
+	if err != nil {
+		panic(err)
+	}
+	return result
+}
+
+// Computes the Cosine similarity between two sparse vectors, returning an error on invalid input
+// ErrZeroMagnitude is returned if either vector is the zero vector, since the angle is undefined
+func TrySparseCosineSimilarity(a Sparse, b Sparse) (float64, error) {
+	if err := validateSparse(a, b); err != nil {
+		return 0, err
+	}
+
+	ma, mb := a.Magnitude(), b.Magnitude()
+	if ma == 0 || mb == 0 {
+		return 0, ErrZeroMagnitude
+	}
+	return a.Dot(b) / (ma * mb), nil
+}
+
+// Computes the Euclidean distance between two sparse vectors
+func SparseEuclidean(a Sparse, b Sparse) float64 {
+	result, err := TrySparseEuclidean(a, b)
+	if err != nil {
+		panic(err)
+	}
+	return result
+}
+
+// Computes the Euclidean distance between two sparse vectors, returning an error on invalid input
+func TrySparseEuclidean(a Sparse, b Sparse) (float64, error) {
+	if err := validateSparse(a, b); err != nil {
+		return 0, err
+	}
+
+	var result float64 = 0
+	sparseDifferences(a, b, func(d float64) { result += d * d })
+	return math.Sqrt(result), nil
+}
+
+// Computes the Manhattan distance between two sparse vectors
+func SparseManhattan(a Sparse, b Sparse) float64 {
+	result, err := TrySparseManhattan(a, b)
+	if err != nil {
+		panic(err)
+	}
+	return result
+}
+
+// Computes the Manhattan distance between two sparse vectors, returning an error on invalid input
+func TrySparseManhattan(a Sparse, b Sparse) (float64, error) {
+	if err := validateSparse(a, b); err != nil {
+		return 0, err
+	}
+
+	var result float64 = 0
+	sparseDifferences(a, b, func(d float64) { result += math.Abs(d) })
+	return result, nil
+}
+
+// Computes the Chebyshev distance between two sparse vectors
+func SparseChebyshev(a Sparse, b Sparse) float64 {
+	result, err := TrySparseChebyshev(a, b)
+	if err != nil {
+		panic(err)
+	}
+	return result
+}
+
+// Computes the Chebyshev distance between two sparse vectors, returning an error on invalid input
+func TrySparseChebyshev(a Sparse, b Sparse) (float64, error) {
+	if err := validateSparse(a, b); err != nil {
+		return 0, err
+	}
+
+	var result float64 = 0
+	sparseDifferences(a, b, func(d float64) { result = math.Max(result, math.Abs(d)) })
+	return result, nil
+}
+
+// Computes the Jaccard similarity between the supports (sets of non-zero indices) of two sparse vectors
+// Jaccard(a,b) = |supp(a) ∩ supp(b)| / |supp(a) ∪ supp(b)|, which is 0 if both vectors are zero
+// This follows set.Jaccard: empty inputs have a similarity of 0, while set.JaccardDistance treats them as identical
+func SparseJaccard(a Sparse, b Sparse) float64 {
+	result, err := TrySparseJaccard(a, b)
+	if err != nil {
+		panic(err)
+	}
+	return result
+}
+
+// Computes the Jaccard similarity between the supports of two sparse vectors, returning an error on invalid input
+func TrySparseJaccard(a Sparse, b Sparse) (float64, error) {
+	if err := validateSparse(a, b); err != nil {
+		return 0, err
+	}
+
+	intersection, i, j := 0, 0, 0
+	for i < len(a.Indices) && j < len(b.Indices) {
+		switch {
+		case a.Indices[i] < b.Indices[j]:
+			i++
+		case a.Indices[i] > b.Indices[j]:
+			j++
+		default:
+			intersection++
+			i++
+			j++
+		}
+	}
+	union := len(a.Indices) + len(b.Indices) - intersection
+	if union == 0 {
+		return 0, nil
+	}
+	return float64(intersection) / float64(union), nil
+}
+
+/* Mixed sparse-dense metrics */
+
+// Validates a sparse and a dense vector before computing a metric on them
+func validateMixed(s Sparse, v Vector) error {
+	if s.Dim == 0 || v.Length() == 0 {
+		return ErrEmptyVector
+	}
+	if s.Dim != v.Length() {
+		return ErrDimensionMismatch
+	}
+	if v.hasNaN() {
+		return ErrNaN
+	}
+	return nil
+}
+
+// Computes the Cosine similarity between a sparse and a dense vector
+func SparseDenseCosineSimilarity(s Sparse, v Vector) float64 {
+	result, err := TrySparseDenseCosineSimilarity(s, v)
+	if err != nil {
+		panic(err)
+	}
+	return result
+}
+
+// Computes the Cosine similarity between a sparse and a dense vector, returning an error on invalid input
+func TrySparseDenseCosineSimilarity(s Sparse, v Vector) (float64, error) {
+	if err := validateMixed(s, v); err != nil {
+		return 0, err
+	}
+
+	ms, mv := s.Magnitude(), v.Magnitude()
+	if ms == 0 || mv == 0 {
+		return 0, ErrZeroMagnitude
+	}
+	return s.DotDense(v) / (ms * mv), nil
+}
+
+// Computes the Euclidean distance between a sparse and a dense vector
+func SparseDenseEuclidean(s Sparse, v Vector) float64 {
+	result, err := TrySparseDenseEuclidean(s, v)
+	if err != nil {
+		panic(err)
+	}
+	return result
+}
+
+// Computes the Euclidean distance between a sparse and a dense vector, returning an error on invalid input
+func TrySparseDenseEuclidean(s Sparse, v Vector) (float64, error) {
+	if err := validateMixed(s, v); err != nil {
+		return 0, err
+	}
+
+	var result float64 = 0
+	denseDifferences(s, v, func(d float64) { result += d * d })
+	return math.Sqrt(result), nil
+}
+
+// Computes the Manhattan distance between a sparse and a dense vector
+func SparseDenseManhattan(s Sparse, v Vector) float64 {
+	result, err := TrySparseDenseManhattan(s, v)
+	if err != nil {
+		panic(err)
+	}
+	return result
+}
+
+// Computes the Manhattan distance between a sparse and a dense vector, returning an error on invalid input
+func TrySparseDenseManhattan(s Sparse, v Vector) (float64, error) {
+	if err := validateMixed(s, v); err != nil {
+		return 0, err
+	}
+
+	var result float64 = 0
+	denseDifferences(s, v, func(d float64) { result += math.Abs(d) })
+	return result, nil
+}
+
+// Computes the Chebyshev distance between a sparse and a dense vector
+func SparseDenseChebyshev(s Sparse, v Vector) float64 {
+	result, err := TrySparseDenseChebyshev(s, v)
+	if err != nil {
+		panic(err)
+	}
+	return result
+}
+
+// Computes the Chebyshev distance between a sparse and a dense vector, returning an error on invalid input
+func TrySparseDenseChebyshev(s Sparse, v Vector) (float64, error) {
+	if err := validateMixed(s, v); err != nil {
+		return 0, err
+	}
+
+	var result float64 = 0
+	denseDifferences(s, v, func(d float64) { result = math.Max(result, math.Abs(d)) })
+	return result, nil
+}
@@ -0,0 +1,128 @@
+package vector
+
+import (
+	"math"
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+func TestNewSparse(t *testing.T) {
+	s, err := NewSparse(6, []int{4, 1, 4, 2, 5}, []float64{1, 3, 2, -1, 0})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := []int{1, 2, 4}; !reflect.DeepEqual(s.Indices, expected) {
+		t.Error("Test Failed,", expected, " expected,", s.Indices, " received.")
+	}
+	if expected := []float64{3, -1, 3}; !reflect.DeepEqual(s.Values, expected) {
+		t.Error("Test Failed,", expected, " expected,", s.Values, " received.")
+	}
+	if expected := (Vector{0, 3, -1, 0, 3, 0}); !reflect.DeepEqual(s.ToDense(), expected) {
+		t.Error("Test Failed,", expected, " expected,", s.ToDense(), " received.")
+	}
+	if output := s.At(4); output != 3 {
+		t.Error("Test Failed,", 3, " expected,", output, " received.")
+	}
+	if output := s.At(3); output != 0 {
+		t.Error("Test Failed,", 0, " expected,", output, " received.")
+	}
+
+	var errorTests = []struct {
+		indices  []int
+		values   []float64
+		expected error
+	}{
+		{[]int{1, 2}, []float64{1}, ErrDimensionMismatch},
+		{[]int{6}, []float64{1}, ErrIndexOutOfRange},
+		{[]int{-1}, []float64{1}, ErrIndexOutOfRange},
+		{[]int{0}, []float64{math.NaN()}, ErrNaN},
+	}
+	for _, test := range errorTests {
+		if _, err := NewSparse(6, test.indices, test.values); err != test.expected {
+			t.Error("Test Failed,", test.expected, " expected,", err, " received.")
+		}
+	}
+}
+
+// Builds a random dense vector of the given dimension with roughly the given fraction of non-zero elements
+func randomSparse(rng *rand.Rand, dim int, density float64) Vector {
+	v := make(Vector, dim)
+	for i := range v {
+		if rng.Float64() < density {
+			v[i] = rng.NormFloat64()
+		}
+	}
+	return v
+}
+
+func TestSparseMetrics(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for trial := 0; trial < 20; trial++ {
+		a, b := randomSparse(rng, 200, 0.1), randomSparse(rng, 200, 0.1)
+		a[0], b[0] = 1, 1 // keep both vectors non-zero
+		sa, sb := ToSparse(a), ToSparse(b)
+
+		if !reflect.DeepEqual(sa.ToDense(), a) {
+			t.Error("Test Failed,", a, " expected,", sa.ToDense(), " received.")
+		}
+
+		var tests = []struct {
+			name     string
+			output   float64
+			expected float64
+		}{
+			{"Magnitude", sa.Magnitude(), a.Magnitude()},
+			{"Dot", sa.Dot(sb), a.Dot(b)},
+			{"CosineSimilarity", SparseCosineSimilarity(sa, sb), CosineSimilarity(a, b)},
+			{"Euclidean", SparseEuclidean(sa, sb), Euclidean(a, b)},
+			{"Manhattan", SparseManhattan(sa, sb), Manhattan(a, b)},
+			{"Chebyshev", SparseChebyshev(sa, sb), Chebyshev(a, b)},
+			{"DotDense", sa.DotDense(b), a.Dot(b)},
+			{"SparseDenseCosineSimilarity", SparseDenseCosineSimilarity(sa, b), CosineSimilarity(a, b)},
+			{"SparseDenseEuclidean", SparseDenseEuclidean(sa, b), Euclidean(a, b)},
+			{"SparseDenseManhattan", SparseDenseManhattan(sa, b), Manhattan(a, b)},
+			{"SparseDenseChebyshev", SparseDenseChebyshev(sa, b), Chebyshev(a, b)},
+		}
+		for _, test := range tests {
+			if math.Abs(test.output-test.expected) > floatDifferenceThresh {
+				t.Error("Test Failed,", test.name, test.expected, " expected,", test.output, " received.")
+			}
+		}
+	}
+}
+
+func TestSparseJaccard(t *testing.T) {
+	var tests = []struct {
+		a        Vector
+		b        Vector
+		expected float64
+	}{
+		{Vector{1, 0, 2, 0}, Vector{3, 4, 0, 0}, 1.0 / 3},
+		{Vector{1, 0, 2, 0}, Vector{5, 0, 7, 0}, 1},
+		{Vector{1, 0, 0, 0}, Vector{0, 0, 0, 1}, 0},
+		{Vector{0, 0}, Vector{0, 0}, 0},
+	}
+
+	for _, test := range tests {
+		if output := SparseJaccard(ToSparse(test.a), ToSparse(test.b)); math.Abs(output-test.expected) > floatDifferenceThresh {
+			t.Error("Test Failed,", test.expected, " expected,", output, " received.")
+		}
+	}
+}
+
+func TestSparseErrors(t *testing.T) {
+	a, b := ToSparse(Vector{1, 0, 2}), ToSparse(Vector{1, 0})
+	if _, err := TrySparseEuclidean(a, b); err != ErrDimensionMismatch {
+		t.Error("Test Failed,", ErrDimensionMismatch, " expected,", err, " received.")
+	}
+	if _, err := TrySparseManhattan(Sparse{}, Sparse{}); err != ErrEmptyVector {
+		t.Error("Test Failed,", ErrEmptyVector, " expected,", err, " received.")
+	}
+	if _, err := TrySparseCosineSimilarity(a, ToSparse(Vector{0, 0, 0})); err != ErrZeroMagnitude {
+		t.Error("Test Failed,", ErrZeroMagnitude, " expected,", err, " received.")
+	}
+	if _, err := TrySparseDenseChebyshev(a, Vector{1, math.NaN(), 0}); err != ErrNaN {
+		t.Error("Test Failed,", ErrNaN, " expected,", err, " received.")
+	}
+}
@@ -0,0 +1,199 @@
+package vector
+
+import (
+	"math"
+)
+
+// Stats is a streaming accumulator of summary statistics over a sequence of values
+// The mean and variance are updated in a single pass with Welford's algorithm and the
+// sum uses Neumaier's compensated summation, so results stay accurate for long inputs.
+// The zero value is an empty accumulator ready for use.
+// A Stats is not safe for concurrent use; accumulate per goroutine and Merge the results.
+// Reference: https://en.wikipedia.org/wiki/Algorithms_for_calculating_variance
+type Stats struct {
+	n    int
+	mean float64
+	m2   float64
+	sum  float64
+	comp float64
+	min  float64
+	max  float64
+}
+
+// Add a new value to the accumulator
+func (s *Stats) Push(x float64) {
+	s.n++
+	if s.n == 1 {
+		s.min, s.max = x, x
+	} else {
+		s.min = math.Min(s.min, x)
+		s.max = math.Max(s.max, x)
+	}
+
+	delta := x - s.mean
+	s.mean += delta / float64(s.n)
+	s.m2 += delta * (x - s.mean)
+
+	t := s.sum + x
+	if math.Abs(s.sum) >= math.Abs(x) {
+		s.comp += (s.sum - t) + x
+	} else {
+		s.comp += (x - t) + s.sum
+	}
+	s.sum = t
+}
+
+// Merge the statistics of another accumulator into this one
+// The result is the same as if every value pushed to o had been pushed to s
+// Reference: Chan et al., "Updating Formulae and a Pairwise Algorithm for Computing Sample Variances"
+func (s *Stats) Merge(o Stats) {
+	if o.n == 0 {
+		return
+	}
+	if s.n == 0 {
+		*s = o
+		return
+	}
+
+	n := s.n + o.n
+	delta := o.mean - s.mean
+	s.mean += delta * float64(o.n) / float64(n)
+	s.m2 += o.m2 + delta*delta*float64(s.n)*float64(o.n)/float64(n)
+	s.min = math.Min(s.min, o.min)
+	s.max = math.Max(s.max, o.max)
+
+	t := s.sum + o.sum
+	if math.Abs(s.sum) >= math.Abs(o.sum) {
+		s.comp += (s.sum - t) + o.sum
+	} else {
+		s.comp += (o.sum - t) + s.sum
+	}
+	s.sum = t
+	s.comp += o.comp
+	s.n = n
+}
+
+// Returns the number of values pushed to the accumulator
+func (s Stats) Count() int {
+	return s.n
+}
+
+// Returns the compensated sum of the values
+func (s Stats) Sum() float64 {
+	return s.sum + s.comp
+}
+
+// Returns the arithmetic mean of the values, or NaN if there are none
+func (s Stats) Mean() float64 {
+	if s.n == 0 {
+		return math.NaN()
+	}
+	return s.mean
+}
+
+// Returns the sample variance (n-1 denominator) of the values
+func (s Stats) Variance() float64 {
+	return s.m2 / float64(s.n-1)
+}
+
+// Returns the population variance (n denominator) of the values
+func (s Stats) PopulationVariance() float64 {
+	return s.m2 / float64(s.n)
+}
+
+// Returns the sample standard deviation of the values
+func (s Stats) Stdev() float64 {
+	return math.Sqrt(s.Variance())
+}
+
+// Returns the smallest value pushed to the accumulator
+func (s Stats) Min() float64 {
+	return s.min
+}
+
+// Returns the largest value pushed to the accumulator
+func (s Stats) Max() float64 {
+	return s.max
+}
+
+// PairStats is a streaming accumulator over a sequence of (x, y) pairs
+// In addition to the per-variable Stats it tracks the co-moment sum((x-mean(x))*(y-mean(y))),
+// from which the covariance and Pearson correlation are derived in a single pass.
+// The zero value is an empty accumulator ready for use.
+type PairStats struct {
+	X        Stats
+	Y        Stats
+	comoment float64
+}
+
+// Add a new (x, y) pair to the accumulator
+func (s *PairStats) Push(x float64, y float64) {
+	dx := x - s.X.mean
+	s.X.Push(x)
+	s.Y.Push(y)
+	s.comoment += dx * (y - s.Y.mean)
+}
+
+// Merge the statistics of another pair accumulator into this one
+func (s *PairStats) Merge(o PairStats) {
+	if o.X.n == 0 {
+		return
+	}
+	if s.X.n == 0 {
+		*s = o
+		return
+	}
+
+	n := float64(s.X.n + o.X.n)
+	dx := o.X.mean - s.X.mean
+	dy := o.Y.mean - s.Y.mean
+	s.comoment += o.comoment + dx*dy*float64(s.X.n)*float64(o.X.n)/n
+	s.X.Merge(o.X)
+	s.Y.Merge(o.Y)
+}
+
+// Returns the number of pairs pushed to the accumulator
+func (s PairStats) Count() int {
+	return s.X.n
+}
+
+// Returns the co-moment sum((x-mean(x))*(y-mean(y))) of the pairs
+func (s PairStats) CoMoment() float64 {
+	return s.comoment
+}
+
+// Returns the sample covariance (n-1 denominator) of the pairs
+func (s PairStats) Covariance() float64 {
+	return s.comoment / float64(s.X.n-1)
+}
+
+// Returns the Pearson correlation of the pairs
+// The result is NaN if either variable has zero variance
+func (s PairStats) Correlation() float64 {
+	if s.X.m2 == 0 || s.Y.m2 == 0 {
+		return math.NaN()
+	}
+	return s.comoment / math.Sqrt(s.X.m2*s.Y.m2)
+}
+
+// Computes the summary statistics of the elements in a vector in a single pass
+func (v Vec[T]) Stats() Stats {
+	var s Stats
+	for _, value := range v {
+		s.Push(float64(value))
+	}
+	return s
+}
+
+// Computes the joint statistics of two vectors of the same length in a single pass
+func PairStatsOf[T Number](a Vec[T], b Vec[T]) PairStats {
+	if a.Length() != b.Length() {
+		panic(ErrDimensionMismatch)
+	}
+
+	var s PairStats
+	for i := 0; i < a.Length(); i++ {
+		s.Push(float64(a[i]), float64(b[i]))
+	}
+	return s
+}
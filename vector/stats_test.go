@@ -0,0 +1,86 @@
+package vector
+
+import (
+	"math"
+	"sync"
+	"testing"
+)
+
+func TestStats(t *testing.T) {
+	var tests = []struct {
+		v        Vector
+		mean     float64
+		variance float64
+		min      float64
+		max      float64
+	}{
+		{Vector{2, 4, 4, 4, 5, 5, 7, 9}, 5, 32.0 / 7, 2, 9},
+		{Vector{-1, -2, -0.2, -4, -5}, -2.44, 4.068, -5, -0.2},
+		// A large offset breaks the naive sum-of-squares formula but not Welford's
+		{Vector{1e9 + 4, 1e9 + 7, 1e9 + 13, 1e9 + 16}, 1e9 + 10, 30, 1e9 + 4, 1e9 + 16},
+	}
+
+	for _, test := range tests {
+		s := test.v.Stats()
+		if s.Count() != test.v.Length() || math.Abs(s.Mean()-test.mean) > floatDifferenceThresh ||
+			math.Abs(s.Variance()-test.variance) > floatDifferenceThresh || s.Min() != test.min || s.Max() != test.max {
+			t.Error("Test Failed,", test.mean, test.variance, test.min, test.max, " expected,", s.Mean(), s.Variance(), s.Min(), s.Max(), " received.")
+		}
+	}
+}
+
+func TestStatsSum(t *testing.T) {
+	var s Stats
+	s.Push(1)
+	s.Push(1e100)
+	s.Push(1)
+	s.Push(-1e100)
+	if output := s.Sum(); output != 2 {
+		t.Error("Test Failed,", 2, " expected,", output, " received.")
+	}
+}
+
+func TestStatsMerge(t *testing.T) {
+	v := Vector{43, 21, 25, 42, 57, 59, 99, 65, 79, 75, 87, 81}
+	expected := v.Stats()
+
+	// Accumulate chunks concurrently and merge the partial results
+	var wg sync.WaitGroup
+	partials := make([]Stats, 3)
+	for i := range partials {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			partials[i] = v[i*4 : (i+1)*4].Stats()
+		}(i)
+	}
+	wg.Wait()
+
+	var merged Stats
+	for _, p := range partials {
+		merged.Merge(p)
+	}
+	if merged.Count() != expected.Count() || math.Abs(merged.Mean()-expected.Mean()) > floatDifferenceThresh ||
+		math.Abs(merged.Variance()-expected.Variance()) > floatDifferenceThresh ||
+		merged.Min() != expected.Min() || merged.Max() != expected.Max() || merged.Sum() != expected.Sum() {
+		t.Error("Test Failed,", expected, " expected,", merged, " received.")
+	}
+}
+
+func TestPairStats(t *testing.T) {
+	a := Vector{43, 21, 25, 42, 57, 59}
+	b := Vector{99, 65, 79, 75, 87, 81}
+	s := PairStatsOf(a, b)
+	if output := s.Correlation(); math.Abs(output-0.5298) > floatDifferenceThresh {
+		t.Error("Test Failed,", 0.5298, " expected,", output, " received.")
+	}
+	if output := s.Covariance(); math.Abs(output-Covariance(a, b)) > floatDifferenceThresh {
+		t.Error("Test Failed,", Covariance(a, b), " expected,", output, " received.")
+	}
+
+	merged := PairStatsOf(a[:2], b[:2])
+	merged.Merge(PairStatsOf(a[2:], b[2:]))
+	if math.Abs(merged.CoMoment()-s.CoMoment()) > floatDifferenceThresh {
+		t.Error("Test Failed,", s.CoMoment(), " expected,", merged.CoMoment(), " received.")
+	}
+}